@@ -0,0 +1,179 @@
+package bash
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/bash"
+)
+
+func init() {
+	languages.Register(&Language{})
+}
+
+// Language implements the Bash (and POSIX sh) language parser
+type Language struct{}
+
+func (l *Language) Name() string           { return "bash" }
+func (l *Language) Extensions() []string   { return []string{".sh", ".bash"} }
+func (l *Language) Interpreters() []string { return []string{"bash", "sh"} }
+
+func (l *Language) TreeSitterLang() *sitter.Language {
+	return bash.GetLanguage()
+}
+
+func (l *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(bash.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Bash file: %w", err)
+	}
+	defer tree.Close()
+
+	e := &extractor{content: content}
+	e.walk(tree.RootNode(), nil)
+
+	return e.imports, e.symbols, nil
+}
+
+// extractor collects imports and symbols while walking the tree
+type extractor struct {
+	content []byte
+	imports []string
+	symbols []languages.Symbol
+}
+
+// walk extracts the symbols under node. Functions are nested under the
+// enclosing function (fn), if any; variables are only extracted outside
+// functions. Sourced files are collected from anywhere in the script.
+func (e *extractor) walk(node *sitter.Node, fn *Function) {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "function_definition":
+			f := &Function{
+				name: languages.FieldContent(child, "name", e.content),
+				doc:  extractDoc(child, e.content),
+				loc:  languages.NodeRange(child),
+			}
+			e.add(f, fn)
+			e.walk(child, f)
+			continue
+		case "declaration_command":
+			if fn == nil {
+				for _, v := range e.extractVariables(child) {
+					e.add(v, nil)
+				}
+			}
+		case "command":
+			if imp := e.extractSource(child); imp != "" {
+				e.imports = append(e.imports, imp)
+			}
+		}
+		e.walk(child, fn)
+	}
+}
+
+// add records a symbol at the top level, or as a child of parent
+func (e *extractor) add(sym languages.Symbol, parent *Function) {
+	if parent == nil {
+		e.symbols = append(e.symbols, sym)
+		return
+	}
+	languages.AddChild(parent, sym)
+}
+
+// extractSource returns the file sourced by a "source" or "." command, or ""
+func (e *extractor) extractSource(node *sitter.Node) string {
+	name := languages.FieldContent(node, "name", e.content)
+	if name != "source" && name != "." {
+		return ""
+	}
+	arg := node.ChildByFieldName("argument")
+	if arg == nil {
+		return ""
+	}
+	return unquote(arg.Content(e.content))
+}
+
+// extractVariables extracts each variable declared by an export, readonly or
+// declare command. Declarations that neither export nor make a variable
+// readonly (e.g., "local x", "declare -a list") are skipped, and so are
+// commands naming functions (e.g., "export -f helper").
+func (e *extractor) extractVariables(node *sitter.Node) []languages.Symbol {
+	keyword := node.Child(0).Type()
+	command := keyword
+	var flags string
+	var names []*sitter.Node
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "word":
+			if text := child.Content(e.content); strings.HasPrefix(text, "-") {
+				flags += text[1:]
+				command += " " + text
+			}
+		case "variable_assignment":
+			if name := child.ChildByFieldName("name"); name != nil {
+				names = append(names, name)
+			}
+		case "variable_name":
+			names = append(names, child)
+		}
+	}
+
+	readonly := keyword == "readonly" || strings.Contains(flags, "r")
+	exported := keyword == "export" || strings.Contains(flags, "x")
+	if keyword == "local" || (!readonly && !exported) || strings.ContainsAny(flags, "fF") {
+		return nil
+	}
+
+	doc := extractDoc(node, e.content)
+	symbols := make([]languages.Symbol, 0, len(names))
+	for _, name := range names {
+		symbols = append(symbols, &Variable{
+			name:     name.Content(e.content),
+			command:  command,
+			readonly: readonly,
+			doc:      doc,
+			loc:      languages.NodeRange(node),
+		})
+	}
+	return symbols
+}
+
+// unquote strips the surrounding quotes from a shell word
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// extractDoc extracts the first line of the run of # comments directly
+// preceding a declaration. The shebang line is not part of a doc comment.
+func extractDoc(node *sitter.Node, content []byte) string {
+	var lines []string
+	expectedRow := node.StartPoint().Row
+	for prev := node.PrevNamedSibling(); prev != nil; prev = prev.PrevNamedSibling() {
+		text := prev.Content(content)
+		if prev.Type() != "comment" || prev.EndPoint().Row+1 != expectedRow || strings.HasPrefix(text, "#!") {
+			break
+		}
+		lines = append([]string{strings.TrimPrefix(text, "#")}, lines...)
+		expectedRow = prev.StartPoint().Row
+	}
+
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
@@ -0,0 +1,160 @@
+package bash
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+func TestLanguageMetadata(t *testing.T) {
+	lang := &Language{}
+
+	if lang.Name() != "bash" {
+		t.Errorf("expected name 'bash', got %q", lang.Name())
+	}
+
+	exts := lang.Extensions()
+	if strings.Join(exts, ",") != ".sh,.bash" {
+		t.Errorf("expected extensions [.sh .bash], got %v", exts)
+	}
+
+	interpreters := lang.Interpreters()
+	if strings.Join(interpreters, ",") != "bash,sh" {
+		t.Errorf("expected interpreters [bash sh], got %v", interpreters)
+	}
+}
+
+func TestParseSource(t *testing.T) {
+	src := `#!/usr/bin/env bash
+source ./lib/common.sh
+. "$(dirname "$0")/env.sh"
+
+if [ -f ~/.deployrc ]; then
+  source ~/.deployrc
+fi
+
+echo source
+`
+	lang := &Language{}
+	imports, _, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	expected := []string{"./lib/common.sh", `$(dirname "$0")/env.sh`, "~/.deployrc"}
+	if strings.Join(imports, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected imports %v, got %v", expected, imports)
+	}
+}
+
+func TestParseFunctions(t *testing.T) {
+	src := `#!/bin/bash
+# Deploy the application
+# to the cluster.
+deploy() {
+  local target=$1
+  # Runs in the deploy scope
+  rollback() {
+    echo "rolling back $target"
+  }
+}
+
+function cleanup {
+  rm -rf /tmp/build
+}
+
+function build() (
+  make all
+)
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	expected := []string{"deploy()", "cleanup()", "build()"}
+	if len(symbols) != len(expected) {
+		t.Fatalf("expected %d symbols, got %d", len(expected), len(symbols))
+	}
+	for i, sym := range symbols {
+		if sym.String() != expected[i] || sym.Kind() != "func" {
+			t.Errorf("expected func %q, got %s %q", expected[i], sym.Kind(), sym.String())
+		}
+	}
+
+	deploy := symbols[0].(*Function)
+	if deploy.DocComment() != "Deploy the application" {
+		t.Errorf("expected doc comment 'Deploy the application', got %q", deploy.DocComment())
+	}
+	if loc := deploy.Location(); loc.Start.Line != 3 || loc.End.Line != 9 {
+		t.Errorf("expected deploy on lines 3-9, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+	if symbols[1].(*Function).DocComment() != "" {
+		t.Errorf("expected no doc comment for cleanup, got %q", symbols[1].(*Function).DocComment())
+	}
+
+	// Nested functions are addressable through their enclosing function
+	found := languages.Lookup(symbols, "deploy.rollback")
+	if len(found) != 1 {
+		t.Fatalf("expected to find deploy.rollback, got %d matches", len(found))
+	}
+	if doc := found[0].(*Function).DocComment(); doc != "Runs in the deploy scope" {
+		t.Errorf("expected doc comment 'Runs in the deploy scope', got %q", doc)
+	}
+}
+
+func TestParseVariables(t *testing.T) {
+	src := `#!/bin/sh
+export APP_ENV=prod
+export PATH="$HOME/bin:$PATH" EDITOR
+# Release version
+readonly VERSION=1.2.3
+declare -rx BUILD_ID=42
+declare -a targets
+LOCAL=1
+
+if [ -n "$CI" ]; then
+  export CI_MODE=1
+fi
+
+setup() {
+  export IN_FUNCTION=1
+}
+export -f setup
+readonly -f setup
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	tests := []struct {
+		kind string
+		str  string
+	}{
+		{"var", "export APP_ENV"},
+		{"var", "export PATH"},
+		{"var", "export EDITOR"},
+		{"const", "readonly VERSION"},
+		{"const", "declare -rx BUILD_ID"},
+		{"var", "export CI_MODE"},
+		{"func", "setup()"},
+	}
+
+	if len(symbols) != len(tests) {
+		t.Fatalf("expected %d symbols, got %d", len(tests), len(symbols))
+	}
+	for i, tt := range tests {
+		sym := symbols[i]
+		if sym.Kind() != tt.kind || sym.String() != tt.str {
+			t.Errorf("expected %s %q, got %s %q", tt.kind, tt.str, sym.Kind(), sym.String())
+		}
+	}
+
+	if doc := symbols[3].(*Variable).DocComment(); doc != "Release version" {
+		t.Errorf("expected doc comment 'Release version', got %q", doc)
+	}
+}
@@ -0,0 +1,43 @@
+package bash
+
+import (
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Function represents a shell function. Functions defined inside its body are its children.
+type Function struct {
+	languages.Nesting
+	name string
+	doc  string
+	loc  languages.Range
+}
+
+func (f *Function) Name() string              { return f.name }
+func (f *Function) Kind() string              { return "func" }
+func (f *Function) Location() languages.Range { return f.loc }
+func (f *Function) String() string            { return f.name + "()" }
+func (f *Function) DocComment() string        { return f.doc }
+
+// Variable represents an exported or readonly variable
+type Variable struct {
+	languages.Nesting
+	name     string
+	command  string // Declaring command with its flags (e.g., "export", "readonly", "declare -rx")
+	readonly bool
+	doc      string
+	loc      languages.Range
+}
+
+func (v *Variable) Name() string { return v.name }
+
+// Kind returns "const" for readonly variables, "var" otherwise
+func (v *Variable) Kind() string {
+	if v.readonly {
+		return "const"
+	}
+	return "var"
+}
+
+func (v *Variable) Location() languages.Range { return v.loc }
+func (v *Variable) String() string            { return v.command + " " + v.name }
+func (v *Variable) DocComment() string        { return v.doc }
@@ -0,0 +1,684 @@
+package cpp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/c"
+	"github.com/smacker/go-tree-sitter/cpp"
+)
+
+func init() {
+	languages.Register(&CLanguage{})
+	languages.Register(&CppLanguage{})
+}
+
+// CLanguage implements C (.c) parsing
+type CLanguage struct{}
+
+func (l *CLanguage) Name() string         { return "c" }
+func (l *CLanguage) Extensions() []string { return []string{".c"} }
+
+func (l *CLanguage) TreeSitterLang() *sitter.Language {
+	return c.GetLanguage()
+}
+
+func (l *CLanguage) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	return parse(content, c.GetLanguage(), "C")
+}
+
+// CppLanguage implements C++ parsing. Headers (.h) are parsed as C++ as
+// well, since the C++ grammar accepts nearly all C declarations.
+type CppLanguage struct{}
+
+func (l *CppLanguage) Name() string { return "cpp" }
+func (l *CppLanguage) Extensions() []string {
+	return []string{".h", ".cc", ".cpp", ".cxx", ".c++", ".hh", ".hpp", ".hxx", ".h++"}
+}
+
+func (l *CppLanguage) TreeSitterLang() *sitter.Language {
+	return cpp.GetLanguage()
+}
+
+func (l *CppLanguage) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	return parse(content, cpp.GetLanguage(), "C++")
+}
+
+func parse(content []byte, lang *sitter.Language, langName string) ([]string, []languages.Symbol, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(lang)
+
+	tree, err := parser.ParseCtx(context.Background(), nil, content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse %s file: %w", langName, err)
+	}
+	defer tree.Close()
+
+	e := &extractor{
+		content:    content,
+		classes:    make(map[string]*Type),
+		namespaces: make(map[string]bool),
+	}
+	symbols := e.declarations(tree.RootNode(), "", nil)
+
+	return e.imports, symbols, nil
+}
+
+// extractor holds the state of a single file's extraction
+type extractor struct {
+	content []byte
+	imports []string
+
+	// Classes and namespaces by "::"-qualified name, used to link out-of-line
+	// definitions (e.g., "void Server::run() {}") to their class
+	classes    map[string]*Type
+	namespaces map[string]bool
+}
+
+// declarations extracts the symbols declared in the named children of node.
+// scope is the "::"-qualified name of the enclosing namespace or class, and
+// class is the enclosing class when node is a class body.
+func (e *extractor) declarations(node *sitter.Node, scope string, class *Type) []languages.Symbol {
+	var symbols []languages.Symbol
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		symbols = append(symbols, e.declaration(child, child, "", scope, class)...)
+	}
+
+	return symbols
+}
+
+// declaration extracts the symbols of a single declaration. outer is the node
+// the declaration's range and doc comment are taken from: the declaration
+// itself, or the template_declaration wrapping it, in which case template holds
+// the template header (e.g., "template <typename T>").
+func (e *extractor) declaration(node, outer *sitter.Node, template, scope string, class *Type) []languages.Symbol {
+	switch node.Type() {
+	case "preproc_include":
+		e.extractInclude(node)
+	case "preproc_def", "preproc_function_def":
+		if !isHeaderGuard(node, e.content) {
+			return []languages.Symbol{e.extractMacro(node)}
+		}
+	case "preproc_if", "preproc_ifdef", "preproc_else", "preproc_elif", "linkage_specification":
+		// Conditional blocks and extern "C" don't open a scope
+		body := node
+		if b := node.ChildByFieldName("body"); b != nil && b.Type() == "declaration_list" {
+			body = b
+		}
+		return e.declarations(body, scope, class)
+	case "namespace_definition":
+		return e.extractNamespace(node, scope)
+	case "template_declaration":
+		header := "template " + languages.Compact(languages.FieldContent(node, "parameters", e.content))
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			if inner := node.NamedChild(i); inner.Type() != "template_parameter_list" {
+				return e.declaration(inner, outer, header, scope, class)
+			}
+		}
+	case "class_specifier", "struct_specifier", "union_specifier", "enum_specifier":
+		if typ := e.extractType(node, outer, template, "", scope); typ != nil {
+			return []languages.Symbol{typ}
+		}
+	case "function_definition":
+		if fn := e.extractFunction(node, node.ChildByFieldName("declarator"), outer, scope, class); fn != nil {
+			return []languages.Symbol{fn}
+		}
+	case "declaration", "field_declaration":
+		return e.extractDeclaration(node, outer, template, scope, class)
+	case "type_definition":
+		return e.extractTypedef(node, outer, scope)
+	case "alias_declaration":
+		return []languages.Symbol{&Typedef{
+			name:      languages.FieldContent(node, "name", e.content),
+			signature: e.signature(outer, nil),
+			doc:       extractDoc(outer, e.content),
+			loc:       languages.NodeRange(outer),
+		}}
+	}
+	return nil
+}
+
+// extractInclude records the path of an #include
+func (e *extractor) extractInclude(node *sitter.Node) {
+	path := languages.FieldContent(node, "path", e.content)
+	path = strings.Trim(path, `<>"`)
+	if path != "" {
+		e.imports = append(e.imports, path)
+	}
+}
+
+// extractMacro extracts an object-like or function-like macro
+func (e *extractor) extractMacro(node *sitter.Node) languages.Symbol {
+	return &Macro{
+		name:   languages.FieldContent(node, "name", e.content),
+		params: languages.Compact(languages.FieldContent(node, "parameters", e.content)),
+		doc:    extractDoc(node, e.content),
+		loc:    trimNewline(languages.NodeRange(node), e.content),
+	}
+}
+
+// isHeaderGuard reports whether a #define is the include guard of the #ifndef
+// it opens (e.g., "#ifndef FOO_H" followed by "#define FOO_H")
+func isHeaderGuard(node *sitter.Node, content []byte) bool {
+	parent := node.Parent()
+	if parent == nil || parent.Type() != "preproc_ifdef" || parent.Child(0).Type() != "#ifndef" {
+		return false
+	}
+	if node.ChildByFieldName("value") != nil {
+		return false
+	}
+	name := languages.FieldContent(node, "name", content)
+	prev := node.PrevNamedSibling()
+	return prev != nil && prev.Type() == "identifier" && prev.Content(content) == name
+}
+
+// extractNamespace extracts a namespace with its declarations. The contents of
+// anonymous namespaces are returned directly, since they add no name.
+func (e *extractor) extractNamespace(node *sitter.Node, scope string) []languages.Symbol {
+	name := languages.FieldContent(node, "name", e.content)
+	body := node.ChildByFieldName("body")
+	if name == "" {
+		if body == nil {
+			return nil
+		}
+		return e.declarations(body, scope, nil)
+	}
+
+	ns := &Namespace{
+		name: name,
+		doc:  extractDoc(node, e.content),
+		loc:  languages.NodeRange(node),
+	}
+	qualified := qualify(scope, name)
+	e.namespaces[qualified] = true
+
+	if body != nil {
+		for _, sym := range e.declarations(body, qualified, nil) {
+			languages.AddChild(ns, sym)
+		}
+	}
+
+	return []languages.Symbol{ns}
+}
+
+// extractType extracts a class, struct, union or enum with its members.
+// typedefName names anonymous types declared in a typedef.
+// Returns nil for references to types declared elsewhere (e.g., "struct point p;").
+func (e *extractor) extractType(node, outer *sitter.Node, template, typedefName, scope string) *Type {
+	body := node.ChildByFieldName("body")
+	if body == nil {
+		return nil
+	}
+
+	typ := &Type{
+		name:     languages.FieldContent(node, "name", e.content),
+		template: template,
+		doc:      extractDoc(outer, e.content),
+		loc:      languages.NodeRange(outer),
+	}
+	if typ.name == "" {
+		if typedefName == "" {
+			return nil
+		}
+		typ.name = typedefName
+		typ.typedef = true
+	}
+
+	typ.keyword = strings.TrimSuffix(node.Type(), "_specifier")
+	if typ.keyword == "enum" {
+		// Scoped enums: "enum class" or "enum struct"
+		for i := 0; i < int(node.ChildCount()); i++ {
+			if kw := node.Child(i).Type(); kw == "class" || kw == "struct" {
+				typ.keyword = "enum " + kw
+			}
+		}
+	}
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == "base_class_clause" {
+			typ.bases = strings.TrimSpace(strings.TrimPrefix(languages.Compact(child.Content(e.content)), ":"))
+		}
+	}
+
+	qualified := qualify(scope, typ.name)
+	e.classes[qualified] = typ
+
+	if body.Type() == "enumerator_list" {
+		for i := 0; i < int(body.NamedChildCount()); i++ {
+			child := body.NamedChild(i)
+			if child.Type() != "enumerator" {
+				continue
+			}
+			languages.AddChild(typ, &Enumerator{
+				name:  languages.FieldContent(child, "name", e.content),
+				value: languages.Compact(languages.FieldContent(child, "value", e.content)),
+				doc:   extractDoc(child, e.content),
+				loc:   languages.NodeRange(child),
+			})
+		}
+		return typ
+	}
+
+	for _, member := range e.declarations(body, qualified, typ) {
+		languages.AddChild(typ, member)
+	}
+
+	return typ
+}
+
+// extractDeclaration extracts the functions and variables of a declaration or
+// field declaration, and the type it defines inline, if any
+func (e *extractor) extractDeclaration(node, outer *sitter.Node, template, scope string, class *Type) []languages.Symbol {
+	var symbols []languages.Symbol
+
+	if typeNode := node.ChildByFieldName("type"); typeNode != nil {
+		switch typeNode.Type() {
+		case "class_specifier", "struct_specifier", "union_specifier", "enum_specifier":
+			// struct point { ... } origin;
+			if typ := e.extractType(typeNode, outer, template, "", scope); typ != nil {
+				symbols = append(symbols, typ)
+			}
+		}
+	}
+
+	for _, decl := range fieldChildren(node, "declarator") {
+		if isFunction(decl) {
+			if fn := e.extractFunction(node, decl, outer, scope, class); fn != nil {
+				symbols = append(symbols, fn)
+			}
+			continue
+		}
+
+		nameNode := declaratorName(decl)
+		if nameNode == nil {
+			continue
+		}
+		kind := "var"
+		if class != nil {
+			kind = "field"
+		}
+		v := &Variable{
+			name:      nameNode.Content(e.content),
+			kind:      kind,
+			signature: e.typePrefix(node) + " " + languages.Compact(stripInitializer(decl).Content(e.content)),
+			doc:       extractDoc(outer, e.content),
+			loc:       languages.NodeRange(outer),
+		}
+		if idx := strings.LastIndex(v.name, "::"); idx >= 0 {
+			// "int Server::count = 0;"
+			v.scope, v.name = stripTemplateArgs(v.name[:idx]), v.name[idx+2:]
+		}
+		symbols = append(symbols, v)
+	}
+
+	return symbols
+}
+
+// extractFunction extracts a function definition or declaration. decl is the
+// declarator holding the function's name and parameters.
+// Out-of-line method definitions are linked to their class when it is declared
+// in the same file, in which case nil is returned.
+func (e *extractor) extractFunction(node, decl, outer *sitter.Node, scope string, class *Type) languages.Symbol {
+	nameNode := declaratorName(decl)
+	if nameNode == nil {
+		return nil
+	}
+
+	fn := &Function{
+		name:      nameNode.Content(e.content),
+		signature: e.signature(outer, node.ChildByFieldName("body")),
+		doc:       extractDoc(outer, e.content),
+		loc:       languages.NodeRange(outer),
+	}
+
+	destructor := nameNode.Type() == "destructor_name"
+	if nameNode.Type() == "qualified_identifier" {
+		// Out-of-line definition: "Server::run", "app::Server::~Server"
+		full := fn.name
+		if idx := strings.LastIndex(full, "::"); idx >= 0 {
+			fn.scope, fn.name = stripTemplateArgs(full[:idx]), full[idx+2:]
+		} else if name := nameNode.ChildByFieldName("name"); name != nil {
+			// Error recovery can produce a qualified identifier without "::"
+			// ("EGLAPIENTRY eglQueryString" when the macro is unknown)
+			fn.name = name.Content(e.content)
+		}
+		destructor = strings.HasPrefix(fn.name, "~")
+	}
+
+	owner := class
+	if fn.scope != "" {
+		owner = e.lookupClass(scope, fn.scope)
+	}
+
+	switch {
+	case owner == nil && fn.scope != "" && !e.isNamespace(scope, fn.scope):
+		// The class is declared in another file (usually a header)
+		fn.kind = memberKind(fn.name, lastSegment(fn.scope), destructor)
+	case owner == nil && node.Type() != "function_definition":
+		fn.kind = "prototype"
+	case owner == nil:
+		fn.kind = "func"
+	default:
+		fn.kind = memberKind(fn.name, owner.name, destructor)
+	}
+
+	if owner != nil && owner != class {
+		languages.AddChild(owner, fn)
+		return nil
+	}
+	return fn
+}
+
+// extractTypedef extracts a typedef, and the type it defines inline, if any
+func (e *extractor) extractTypedef(node, outer *sitter.Node, scope string) []languages.Symbol {
+	var decls []*sitter.Node
+	for _, decl := range fieldChildren(node, "declarator") {
+		if declaratorName(decl) != nil {
+			decls = append(decls, decl)
+		}
+	}
+	if len(decls) == 0 {
+		return nil
+	}
+
+	doc := extractDoc(outer, e.content)
+	signature := e.signature(outer, nil)
+
+	var symbols []languages.Symbol
+	if typeNode := node.ChildByFieldName("type"); typeNode != nil && typeNode.ChildByFieldName("body") != nil {
+		// typedef struct point { ... } Point;
+		typ := e.extractType(typeNode, outer, "", declaratorName(decls[0]).Content(e.content), scope)
+		if typ == nil {
+			return nil
+		}
+		symbols = append(symbols, typ)
+		if typ.typedef {
+			// The anonymous type took the name of the first declarator
+			decls = decls[1:]
+		}
+		var names []string
+		for _, decl := range decls {
+			names = append(names, languages.Compact(decl.Content(e.content)))
+		}
+		signature = e.typePrefix(node) + " " + strings.Join(names, ", ")
+	}
+
+	for _, decl := range decls {
+		symbols = append(symbols, &Typedef{
+			name:      declaratorName(decl).Content(e.content),
+			signature: signature,
+			doc:       doc,
+			loc:       languages.NodeRange(outer),
+		})
+	}
+
+	return symbols
+}
+
+// lookupClass finds the class an out-of-line definition with the given
+// qualifier belongs to, searching outwards from the enclosing scope like C++
+// name lookup does
+func (e *extractor) lookupClass(scope, qualifier string) *Type {
+	for {
+		if typ, ok := e.classes[qualify(scope, qualifier)]; ok {
+			return typ
+		}
+		if scope == "" {
+			return nil
+		}
+		scope = trimLastSegment(scope)
+	}
+}
+
+// isNamespace reports whether a qualifier names a namespace declared in this file
+func (e *extractor) isNamespace(scope, qualifier string) bool {
+	for {
+		if e.namespaces[qualify(scope, qualifier)] {
+			return true
+		}
+		if scope == "" {
+			return false
+		}
+		scope = trimLastSegment(scope)
+	}
+}
+
+// signature returns the source of a declaration up to its body (or the end of
+// the declaration), with whitespace collapsed and without the trailing semicolon.
+// Constructor initializer lists are left out.
+func (e *extractor) signature(node, body *sitter.Node) string {
+	end := node.EndByte()
+	if body != nil {
+		end = body.StartByte()
+	}
+	if init := languages.FirstChildOfType(node, "field_initializer_list"); init != nil && init.StartByte() < end {
+		end = init.StartByte()
+	}
+	text := languages.Compact(string(e.content[node.StartByte():end]))
+	return strings.TrimSpace(strings.TrimSuffix(text, ";"))
+}
+
+// typePrefix returns the specifiers and type of a declaration (e.g., "static const char"),
+// abbreviating inline type definitions to their keyword and name
+func (e *extractor) typePrefix(node *sitter.Node) string {
+	typeNode := node.ChildByFieldName("type")
+	if typeNode == nil {
+		return ""
+	}
+
+	prefix := languages.Compact(string(e.content[node.StartByte():typeNode.StartByte()]))
+	typeStr := languages.Compact(typeNode.Content(e.content))
+	if typeNode.ChildByFieldName("body") != nil {
+		typeStr = strings.TrimSuffix(typeNode.Type(), "_specifier")
+		if name := languages.FieldContent(typeNode, "name", e.content); name != "" {
+			typeStr += " " + name
+		}
+	}
+
+	if prefix == "" {
+		return typeStr
+	}
+	return prefix + " " + typeStr
+}
+
+// memberKind returns the kind of a method named name in the class className
+func memberKind(name, className string, destructor bool) string {
+	switch {
+	case destructor:
+		return "destructor"
+	case name == stripTemplateArgs(className):
+		return "constructor"
+	default:
+		return "method"
+	}
+}
+
+// isFunction reports whether a declarator declares a function (as opposed to
+// a variable, including pointers to functions)
+func isFunction(decl *sitter.Node) bool {
+	for node := decl; node != nil; node = innerDeclarator(node) {
+		switch node.Type() {
+		case "function_declarator":
+			inner := innerDeclarator(node)
+			return inner == nil || inner.Type() != "parenthesized_declarator"
+		case "init_declarator", "parenthesized_declarator":
+			return false
+		}
+	}
+	return false
+}
+
+// declaratorName returns the node naming a declarator (an identifier,
+// qualified identifier, destructor or operator name), or nil if there is none
+func declaratorName(decl *sitter.Node) *sitter.Node {
+	for node := decl; node != nil; node = innerDeclarator(node) {
+		switch node.Type() {
+		case "identifier", "field_identifier", "type_identifier", "qualified_identifier",
+			"destructor_name", "operator_name", "template_function":
+			return node
+		}
+	}
+	return nil
+}
+
+// innerDeclarator returns the declarator nested in a pointer, reference, array,
+// function or parenthesized declarator
+func innerDeclarator(node *sitter.Node) *sitter.Node {
+	if inner := node.ChildByFieldName("declarator"); inner != nil {
+		return inner
+	}
+	switch node.Type() {
+	case "reference_declarator", "parenthesized_declarator":
+		if node.NamedChildCount() > 0 {
+			return node.NamedChild(0)
+		}
+	}
+	return nil
+}
+
+// stripInitializer returns the declarator of an init_declarator ("x = 1" -> "x")
+func stripInitializer(decl *sitter.Node) *sitter.Node {
+	if decl.Type() == "init_declarator" {
+		if inner := decl.ChildByFieldName("declarator"); inner != nil {
+			return inner
+		}
+	}
+	return decl
+}
+
+// fieldChildren returns every child of node stored under the given field name
+func fieldChildren(node *sitter.Node, field string) []*sitter.Node {
+	var children []*sitter.Node
+	for i := 0; i < int(node.ChildCount()); i++ {
+		if node.FieldNameForChild(i) == field {
+			children = append(children, node.Child(i))
+		}
+	}
+	return children
+}
+
+// qualify joins a scope and a name with "::"
+func qualify(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "::" + name
+}
+
+// lastSegment returns the last component of a "::"-qualified name
+func lastSegment(name string) string {
+	if idx := strings.LastIndex(name, "::"); idx >= 0 {
+		return name[idx+2:]
+	}
+	return name
+}
+
+// trimLastSegment removes the last component of a "::"-qualified name
+func trimLastSegment(name string) string {
+	if idx := strings.LastIndex(name, "::"); idx >= 0 {
+		return name[:idx]
+	}
+	return ""
+}
+
+// stripTemplateArgs removes template arguments from a qualified name
+// ("Box<T>::Iter" -> "Box::Iter")
+func stripTemplateArgs(name string) string {
+	var sb strings.Builder
+	depth := 0
+	for _, r := range name {
+		switch {
+		case r == '<':
+			depth++
+		case r == '>' && depth > 0:
+			depth--
+		case depth == 0:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// trimNewline excludes the trailing newline that preprocessor directives
+// include, ending the range at the end of the previous line instead
+func trimNewline(r languages.Range, content []byte) languages.Range {
+	if r.End.Character == 0 && r.End.Line > r.Start.Line {
+		lines := bytes.Split(content, []byte("\n"))
+		r.End.Line--
+		r.End.Character = len(bytes.TrimSuffix(lines[r.End.Line], []byte("\r")))
+	}
+	return r
+}
+
+// extractDoc extracts the first line of the comment block directly preceding a
+// declaration. Comments trailing the previous declaration on its line are ignored.
+func extractDoc(node *sitter.Node, content []byte) string {
+	prev := node.PrevNamedSibling()
+	if prev == nil || prev.Type() != "comment" || isTrailingComment(prev) {
+		return ""
+	}
+	if node.StartPoint().Row-prev.EndPoint().Row > 1 {
+		return ""
+	}
+
+	// Walk back to the first of a run of line comments
+	first := prev
+	for {
+		before := first.PrevNamedSibling()
+		if before == nil || before.Type() != "comment" || isTrailingComment(before) ||
+			first.StartPoint().Row-before.EndPoint().Row > 1 {
+			break
+		}
+		first = before
+	}
+
+	return commentText(first.Content(content))
+}
+
+// isTrailingComment reports whether a comment follows code on the same line
+func isTrailingComment(comment *sitter.Node) bool {
+	before := comment.PrevSibling()
+	if before == nil {
+		return false
+	}
+	// Preprocessor directives end at the start of the next line
+	end := before.EndPoint()
+	return end.Row == comment.StartPoint().Row && end.Column > 0
+}
+
+// commentText returns the first line of a comment without its markers.
+// Doxygen "@brief" markers are dropped; comments starting with other
+// commands (e.g., "@param") have no summary.
+func commentText(text string) string {
+	if strings.HasPrefix(text, "/*") {
+		text = strings.TrimPrefix(text, "/*")
+		text = strings.TrimLeft(text, "*!")
+		text = strings.TrimSuffix(text, "*/")
+	} else {
+		text = strings.TrimLeft(text, "/!<")
+	}
+
+	for line := range strings.SplitSeq(text, "\n") {
+		line = strings.TrimSpace(line)
+		line = strings.TrimPrefix(line, "*")
+		line = strings.TrimSpace(line)
+		for _, brief := range []string{"@brief ", `\brief `} {
+			line = strings.TrimPrefix(line, brief)
+		}
+		if strings.HasPrefix(line, "@") || strings.HasPrefix(line, `\`) {
+			return ""
+		}
+		if line != "" {
+			return line
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,531 @@
+package cpp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+	"github.com/roveo/topo-mcp/languages/languagetest"
+)
+
+func TestLanguageMetadata(t *testing.T) {
+	c := &CLanguage{}
+	if c.Name() != "c" {
+		t.Errorf("expected name 'c', got %q", c.Name())
+	}
+	if exts := c.Extensions(); len(exts) != 1 || exts[0] != ".c" {
+		t.Errorf("expected extensions [.c], got %v", exts)
+	}
+
+	cpp := &CppLanguage{}
+	if cpp.Name() != "cpp" {
+		t.Errorf("expected name 'cpp', got %q", cpp.Name())
+	}
+	exts := strings.Join(cpp.Extensions(), " ")
+	for _, ext := range []string{".h", ".cc", ".cpp", ".hpp"} {
+		if !strings.Contains(exts+" ", ext+" ") {
+			t.Errorf("expected C++ extensions to include %s, got %v", ext, cpp.Extensions())
+		}
+	}
+}
+
+// symbolStrings returns "kind: String()" for each top-level symbol
+func symbolStrings(symbols []languages.Symbol) []string {
+	var strs []string
+	for _, sym := range symbols {
+		strs = append(strs, sym.Kind()+": "+sym.String())
+	}
+	return strs
+}
+
+func TestParseC(t *testing.T) {
+	src := `#include <stdio.h>
+#include "util/list.h"
+
+#define MAX_ITEMS 64
+#define SQUARE(x) ((x) * (x))
+
+/* A point in 2D space */
+struct point {
+    int x;
+    int y;
+};
+
+typedef struct {
+    char *name;
+    int refs;
+} Item;
+
+typedef unsigned long ulong;
+typedef int (*compare_fn)(const void *, const void *);
+
+union value { int i; double d; };
+
+enum color { RED, GREEN = 2 };
+
+static const char *names[] = {"a", "b"};
+int counter = 0;
+
+int add(int a, int b);
+
+/**
+ * Find an item by name.
+ * @param name the name
+ */
+static Item *find(const char *name) {
+    return NULL;
+}
+`
+	lang := &CLanguage{}
+	imports, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if strings.Join(imports, ",") != "stdio.h,util/list.h" {
+		t.Errorf("unexpected imports: %v", imports)
+	}
+
+	expected := []string{
+		"macro: #define MAX_ITEMS",
+		"macro: #define SQUARE(x)",
+		"struct: struct point",
+		"struct: typedef struct Item",
+		"type: typedef unsigned long ulong",
+		"type: typedef int (*compare_fn)(const void *, const void *)",
+		"union: union value",
+		"enum: enum color",
+		"var: static const char *names[]",
+		"var: int counter",
+		"prototype: int add(int a, int b)",
+		"func: static Item *find(const char *name)",
+	}
+	got := symbolStrings(symbols)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected symbols:\ngot:  %q\nwant: %q", got, expected)
+	}
+
+	// Macros end at the end of their line, not at the start of the next one
+	if loc := symbols[0].Location(); loc.Start.Line != 3 || loc.End.Line != 3 || loc.End.Character != 20 {
+		t.Errorf("expected MAX_ITEMS at 3:0-3:20, got %d:%d-%d:%d", loc.Start.Line, loc.Start.Character, loc.End.Line, loc.End.Character)
+	}
+
+	point := symbols[2].(*Type)
+	if point.DocComment() != "A point in 2D space" {
+		t.Errorf("expected doc comment 'A point in 2D space', got %q", point.DocComment())
+	}
+	if fields := languagetest.ChildStrings(point); strings.Join(fields, ",") != "int x,int y" {
+		t.Errorf("unexpected fields: %q", fields)
+	}
+
+	item := symbols[3].(*Type)
+	if fields := languagetest.ChildStrings(item); strings.Join(fields, ",") != "char *name,int refs" {
+		t.Errorf("unexpected fields: %q", fields)
+	}
+
+	color := symbols[7].(*Type)
+	if enumerators := languagetest.ChildStrings(color); strings.Join(enumerators, ",") != "RED,GREEN = 2" {
+		t.Errorf("unexpected enumerators: %q", enumerators)
+	}
+
+	if names := symbols[8]; names.Name() != "names" {
+		t.Errorf("expected variable name 'names', got %q", names.Name())
+	}
+
+	find := symbols[11].(*Function)
+	if find.Name() != "find" {
+		t.Errorf("expected name 'find', got %q", find.Name())
+	}
+	if find.DocComment() != "Find an item by name." {
+		t.Errorf("expected doc comment 'Find an item by name.', got %q", find.DocComment())
+	}
+	if loc := find.Location(); loc.Start.Line != 33 || loc.End.Line != 35 {
+		t.Errorf("expected find() on lines 33-35, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+}
+
+func TestParseHeaderGuard(t *testing.T) {
+	src := `#ifndef UTIL_H
+#define UTIL_H
+
+#include <stddef.h>
+
+#define UTIL_VERSION 3
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+// Allocate a buffer.
+void *util_alloc(size_t n);
+
+#ifdef __cplusplus
+}
+#endif
+
+#endif /* UTIL_H */
+`
+	lang := &CppLanguage{}
+	imports, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(imports) != 1 || imports[0] != "stddef.h" {
+		t.Errorf("expected imports [stddef.h], got %v", imports)
+	}
+
+	expected := []string{
+		"macro: #define UTIL_VERSION",
+		"prototype: void *util_alloc(size_t n)",
+	}
+	got := symbolStrings(symbols)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected symbols:\ngot:  %q\nwant: %q", got, expected)
+	}
+
+	if doc := symbols[1].(*Function).DocComment(); doc != "Allocate a buffer." {
+		t.Errorf("expected doc comment 'Allocate a buffer.', got %q", doc)
+	}
+}
+
+func TestParseClass(t *testing.T) {
+	src := `/// An HTTP server
+class Server : public Base, private Mixin {
+public:
+    explicit Server(int port);
+    virtual ~Server();
+
+    /// Start serving requests
+    virtual void run() const override;
+    int port() const { return port_; }
+    Server &operator=(const Server &) = delete;
+    static Server *create();
+
+private:
+    struct Options {
+        bool verbose;
+    };
+    enum class Mode { Blocking, Async };
+    using Handler = void (*)(int);
+
+    int port_;
+    static int count_;
+    friend class Client;
+};
+`
+	lang := &CppLanguage{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 symbol, got %d", len(symbols))
+	}
+
+	cls := symbols[0].(*Type)
+	if cls.Kind() != "class" {
+		t.Errorf("expected kind 'class', got %q", cls.Kind())
+	}
+	if cls.String() != "class Server : public Base, private Mixin" {
+		t.Errorf("unexpected String(): %q", cls.String())
+	}
+	if cls.DocComment() != "An HTTP server" {
+		t.Errorf("expected doc comment 'An HTTP server', got %q", cls.DocComment())
+	}
+
+	expected := []string{
+		"explicit Server(int port)",
+		"virtual ~Server()",
+		"virtual void run() const override",
+		"int port() const",
+		"Server &operator=(const Server &) = delete",
+		"static Server *create()",
+		"struct Options",
+		"enum class Mode",
+		"using Handler = void (*)(int)",
+		"int port_",
+		"static int count_",
+	}
+	got := languagetest.ChildStrings(cls)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected members:\ngot:  %q\nwant: %q", got, expected)
+	}
+
+	kinds := []string{"constructor", "destructor", "method", "method", "method", "method", "struct", "enum", "type", "field", "field"}
+	for i, m := range cls.Children() {
+		if m.Kind() != kinds[i] {
+			t.Errorf("member %q: expected kind %q, got %q", m.Name(), kinds[i], m.Kind())
+		}
+	}
+
+	run := cls.Children()[2].(*Function)
+	if run.DocComment() != "Start serving requests" {
+		t.Errorf("expected doc comment 'Start serving requests', got %q", run.DocComment())
+	}
+	if name := cls.Children()[1].Name(); name != "~Server" {
+		t.Errorf("expected destructor name '~Server', got %q", name)
+	}
+}
+
+func TestParseNamespacesAndTemplates(t *testing.T) {
+	src := `namespace app {
+namespace detail {
+int helper();
+}
+
+template <typename T, size_t N = 8>
+class Buffer {
+public:
+    template <typename U>
+    U as() const;
+};
+
+template <typename T>
+T max(T a, T b) { return a > b ? a : b; }
+}
+
+namespace a::b {
+void f();
+}
+
+namespace {
+int hidden() { return 0; }
+}
+`
+	lang := &CppLanguage{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	expected := []string{
+		"namespace: namespace app",
+		"namespace: namespace a::b",
+		"func: int hidden()",
+	}
+	got := symbolStrings(symbols)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected symbols:\ngot:  %q\nwant: %q", got, expected)
+	}
+
+	app := symbols[0].(*Namespace)
+	expected = []string{
+		"namespace detail",
+		"template <typename T, size_t N = 8> class Buffer",
+		"template <typename T> T max(T a, T b)",
+	}
+	got = languagetest.ChildStrings(app)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected namespace members:\ngot:  %q\nwant: %q", got, expected)
+	}
+
+	buffer := app.Children()[1].(*Type)
+	if loc := buffer.Location(); loc.Start.Line != 5 {
+		t.Errorf("expected template range to start on line 5, got %d", loc.Start.Line)
+	}
+	as := buffer.Children()[0]
+	if as.String() != "template <typename U> U as() const" {
+		t.Errorf("unexpected String(): %q", as.String())
+	}
+
+	tests := []struct {
+		selector string
+		want     string
+	}{
+		{"app::detail::helper", "int helper()"},
+		{"app::Buffer::as", "template <typename U> U as() const"},
+		{"app.Buffer.as", "template <typename U> U as() const"},
+		{"a::b::f", "void f()"},
+	}
+	for _, tt := range tests {
+		found := languages.Lookup(symbols, tt.selector)
+		if len(found) != 1 || found[0].String() != tt.want {
+			t.Errorf("Lookup(%q): expected %q, got %v", tt.selector, tt.want, symbolStrings(found))
+		}
+	}
+}
+
+func TestParseOutOfLineDefinitions(t *testing.T) {
+	src := `namespace app {
+class Server {
+public:
+    Server(int port);
+    ~Server();
+    void run();
+    struct Conn {
+        void close();
+    };
+};
+
+void Server::run() {}
+}
+
+app::Server::Server(int port) : port_(port) {}
+app::Server::~Server() {}
+void app::Server::Conn::close() {}
+`
+	lang := &CppLanguage{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	// The definitions are linked to the class rather than listed at the top level
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 symbol, got %v", symbolStrings(symbols))
+	}
+
+	server := symbols[0].(*Namespace).Children()[0].(*Type)
+	expected := []string{
+		"Server(int port)",
+		"~Server()",
+		"void run()",
+		"struct Conn",
+		"void Server::run()",
+		"app::Server::Server(int port)",
+		"app::Server::~Server()",
+	}
+	got := languagetest.ChildStrings(server)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected members:\ngot:  %q\nwant: %q", got, expected)
+	}
+
+	ctor := server.Children()[5]
+	if ctor.Kind() != "constructor" || ctor.Name() != "Server" {
+		t.Errorf("expected constructor Server, got %s %q", ctor.Kind(), ctor.Name())
+	}
+	if got := languages.QualifiedName(ctor); got != "app.Server.Server" {
+		t.Errorf("expected qualified name 'app.Server.Server', got %q", got)
+	}
+	if dtor := server.Children()[6]; dtor.Kind() != "destructor" {
+		t.Errorf("expected kind 'destructor', got %q", dtor.Kind())
+	}
+
+	conn := server.Children()[3].(*Type)
+	if got := languagetest.ChildStrings(conn); len(got) != 2 || got[1] != "void app::Server::Conn::close()" {
+		t.Errorf("expected close() to be linked to Conn, got %q", got)
+	}
+
+	// Declaration and definition share the qualified name
+	if found := languages.Lookup(symbols, "app::Server::run"); len(found) != 2 {
+		t.Errorf("expected declaration and definition of app::Server::run, got %d", len(found))
+	}
+}
+
+func TestParseUnlinkedDefinitions(t *testing.T) {
+	src := `#include "server.h"
+
+namespace app {
+
+// Serve until stopped.
+void Server::run() {}
+
+}
+
+template <typename T>
+typename Box<T>::Iter Box<T>::begin() { return {}; }
+
+int Server::count = 0;
+`
+	lang := &CppLanguage{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 3 {
+		t.Fatalf("expected 3 symbols, got %v", symbolStrings(symbols))
+	}
+
+	// The class is declared in the header, so the definition keeps its qualifier
+	run := symbols[0].(*Namespace).Children()[0].(*Function)
+	if run.Name() != "run" || run.Kind() != "method" {
+		t.Errorf("expected method run, got %s %q", run.Kind(), run.Name())
+	}
+	if run.DocComment() != "Serve until stopped." {
+		t.Errorf("expected doc comment 'Serve until stopped.', got %q", run.DocComment())
+	}
+	if sel := languages.Selectors(run); sel[0] != "app::Server::run" {
+		t.Errorf("expected selector 'app::Server::run', got %v", sel)
+	}
+	// The definition also answers to the name as written, and its path goes through the class
+	for _, selector := range []string{"Server::run", "app.Server.run"} {
+		if found := languages.Lookup(symbols, selector); len(found) != 1 || found[0] != run {
+			t.Errorf("expected %q to find run, got %v", selector, symbolStrings(found))
+		}
+	}
+
+	begin := symbols[1]
+	if sel := languages.Selectors(begin); sel[0] != "Box::begin" {
+		t.Errorf("expected selector 'Box::begin', got %v", sel)
+	}
+	if begin.String() != "template <typename T> typename Box<T>::Iter Box<T>::begin()" {
+		t.Errorf("unexpected String(): %q", begin.String())
+	}
+
+	if count := symbols[2]; count.Name() != "count" || count.Kind() != "var" {
+		t.Errorf("expected var count, got %s %q", count.Kind(), count.Name())
+	}
+	if sel := languages.Selectors(symbols[2]); sel[0] != "Server::count" {
+		t.Errorf("expected selector 'Server::count', got %v", sel)
+	}
+}
+
+func TestNestedSelectors(t *testing.T) {
+	src := `namespace app {
+namespace detail {
+using Handle = int;
+}
+
+struct Config {
+    int port;
+    enum Mode { Fast, Safe };
+    typedef unsigned long Size;
+};
+}
+`
+	lang := &CppLanguage{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	tests := []struct {
+		selector string
+		want     string
+	}{
+		{"app::detail", "namespace detail"},
+		{"app::detail::Handle", "using Handle = int"},
+		{"app::Config::port", "int port"},
+		{"app::Config::Mode", "enum Mode"},
+		{"app::Config::Mode::Safe", "Safe"},
+		{"app::Config::Size", "typedef unsigned long Size"},
+	}
+	for _, tt := range tests {
+		found := languages.Lookup(symbols, tt.selector)
+		if len(found) != 1 || found[0].String() != tt.want {
+			t.Errorf("Lookup(%q): expected %q, got %v", tt.selector, tt.want, symbolStrings(found))
+		}
+	}
+}
+
+func TestParseUnknownCallingConventionMacro(t *testing.T) {
+	// From EGL/egl.h: the grammar reads "EGLAPIENTRY eglQueryString" as a
+	// qualified identifier with a missing "::"
+	src := `EGLAPI const char *EGLAPIENTRY eglQueryString (EGLDisplay dpy, EGLint name);
+`
+	lang := &CppLanguage{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 symbol, got %v", symbolStrings(symbols))
+	}
+	if fn := symbols[0]; fn.Name() != "eglQueryString" || fn.Kind() != "prototype" {
+		t.Errorf("expected prototype eglQueryString, got %s %q", fn.Kind(), fn.Name())
+	}
+}
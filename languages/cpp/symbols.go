@@ -0,0 +1,181 @@
+package cpp
+
+import (
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Macro represents a #define
+type Macro struct {
+	name   string
+	params string // e.g., "(x, y)" for function-like macros
+	doc    string
+	loc    languages.Range
+}
+
+func (m *Macro) Name() string              { return m.name }
+func (m *Macro) Kind() string              { return "macro" }
+func (m *Macro) Location() languages.Range { return m.loc }
+func (m *Macro) String() string            { return "#define " + m.name + m.params }
+func (m *Macro) DocComment() string        { return m.doc }
+
+// Namespace represents a named namespace. Its declarations are its children.
+type Namespace struct {
+	languages.Nesting
+	name string // May be nested (e.g., "a::b")
+	doc  string
+	loc  languages.Range
+}
+
+func (n *Namespace) Name() string              { return n.name }
+func (n *Namespace) Kind() string              { return "namespace" }
+func (n *Namespace) Location() languages.Range { return n.loc }
+func (n *Namespace) String() string            { return "namespace " + n.name }
+func (n *Namespace) DocComment() string        { return n.doc }
+func (n *Namespace) Selectors() []string       { return scopedSelectors(n, "") }
+
+// Type represents a class, struct, union or enum. Members, nested types,
+// enumerators and linked out-of-line method definitions are its children.
+type Type struct {
+	languages.Nesting
+	name     string
+	keyword  string // "class", "struct", "union", "enum", "enum class" or "enum struct"
+	template string // e.g., "template <typename T>"
+	bases    string // e.g., "public Base, private Mixin"
+	typedef  bool   // Anonymous type named by a typedef
+	doc      string
+	loc      languages.Range
+}
+
+func (t *Type) Name() string { return t.name }
+func (t *Type) Kind() string {
+	if strings.HasPrefix(t.keyword, "enum") {
+		return "enum"
+	}
+	return t.keyword
+}
+func (t *Type) Location() languages.Range { return t.loc }
+func (t *Type) String() string {
+	var sb strings.Builder
+	if t.template != "" {
+		sb.WriteString(t.template)
+		sb.WriteString(" ")
+	}
+	if t.typedef {
+		sb.WriteString("typedef ")
+	}
+	sb.WriteString(t.keyword)
+	sb.WriteString(" ")
+	sb.WriteString(t.name)
+	if t.bases != "" {
+		sb.WriteString(" : ")
+		sb.WriteString(t.bases)
+	}
+	return sb.String()
+}
+func (t *Type) DocComment() string  { return t.doc }
+func (t *Type) Selectors() []string { return scopedSelectors(t, "") }
+
+// Function represents a function definition, a prototype, or a method,
+// constructor or destructor declared in a class or defined out of line
+type Function struct {
+	languages.Nesting
+	name      string
+	scope     string // Qualifier of an out-of-line definition, without template arguments (e.g., "app::Server")
+	kind      string // "func", "prototype", "method", "constructor" or "destructor"
+	signature string // The declaration as written, up to the body
+	doc       string
+	loc       languages.Range
+}
+
+func (f *Function) Name() string              { return f.name }
+func (f *Function) Kind() string              { return f.kind }
+func (f *Function) Location() languages.Range { return f.loc }
+func (f *Function) String() string            { return f.signature }
+func (f *Function) DocComment() string        { return f.doc }
+
+// Scope returns the qualifier of an out-of-line definition that wasn't linked
+// to a class in the same file, or ""
+func (f *Function) Scope() string {
+	if _, linked := f.Parent().(*Type); linked {
+		return ""
+	}
+	return f.scope
+}
+
+// Selectors returns the "::"-qualified name (e.g., "app::Server::run").
+// Out-of-line definitions in a namespace also answer to the name as written
+// (e.g., "Server::run" in "namespace app { void Server::run() {} }").
+func (f *Function) Selectors() []string { return scopedSelectors(f, f.Scope()) }
+
+// Typedef represents a typedef or a using alias
+type Typedef struct {
+	languages.Nesting
+	name      string
+	signature string // e.g., "typedef unsigned long ulong", "using Ptr = Server*"
+	doc       string
+	loc       languages.Range
+}
+
+func (t *Typedef) Name() string              { return t.name }
+func (t *Typedef) Kind() string              { return "type" }
+func (t *Typedef) Location() languages.Range { return t.loc }
+func (t *Typedef) String() string            { return t.signature }
+func (t *Typedef) DocComment() string        { return t.doc }
+func (t *Typedef) Selectors() []string       { return scopedSelectors(t, "") }
+
+// Variable represents a global variable or a class member field
+type Variable struct {
+	languages.Nesting
+	name      string
+	kind      string // "var" or "field"
+	scope     string // Qualifier of an out-of-line definition (e.g., "Server" in "int Server::count")
+	signature string // e.g., "static const char *names[]"
+	doc       string
+	loc       languages.Range
+}
+
+func (v *Variable) Name() string              { return v.name }
+func (v *Variable) Kind() string              { return v.kind }
+func (v *Variable) Location() languages.Range { return v.loc }
+func (v *Variable) String() string            { return v.signature }
+func (v *Variable) DocComment() string        { return v.doc }
+func (v *Variable) Scope() string             { return v.scope }
+func (v *Variable) Selectors() []string       { return scopedSelectors(v, v.scope) }
+
+// Enumerator represents an enum constant
+type Enumerator struct {
+	languages.Nesting
+	name  string
+	value string // Explicit value, if any
+	doc   string
+	loc   languages.Range
+}
+
+func (e *Enumerator) Name() string              { return e.name }
+func (e *Enumerator) Kind() string              { return "enumerator" }
+func (e *Enumerator) Location() languages.Range { return e.loc }
+func (e *Enumerator) String() string {
+	if e.value != "" {
+		return e.name + " = " + e.value
+	}
+	return e.name
+}
+func (e *Enumerator) DocComment() string  { return e.doc }
+func (e *Enumerator) Selectors() []string { return scopedSelectors(e, "") }
+
+// scopedSelectors returns the "::"-separated path of a symbol, or nil for
+// unqualified top-level symbols. Nested symbols declared with a qualifier
+// (see Scoped) also answer to the qualified name as written.
+func scopedSelectors(sym languages.Symbol, scope string) []string {
+	path := languages.Path(sym)
+	if len(path) < 2 {
+		return nil
+	}
+	selectors := []string{strings.Join(path, "::")}
+	if scope != "" && languages.ParentOf(sym) != nil {
+		selectors = append(selectors, scope+"::"+sym.Name())
+	}
+	return selectors
+}
@@ -0,0 +1,394 @@
+package csharp
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/csharp"
+)
+
+func init() {
+	languages.Register(&Language{})
+}
+
+// Language implements the C# language parser
+type Language struct{}
+
+func (l *Language) Name() string         { return "csharp" }
+func (l *Language) Extensions() []string { return []string{".cs"} }
+
+func (l *Language) TreeSitterLang() *sitter.Language {
+	return csharp.GetLanguage()
+}
+
+func (l *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(csharp.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse C# file: %w", err)
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	var imports []string
+	var symbols []languages.Symbol
+	var fileNamespace *Namespace
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		child := root.NamedChild(i)
+		switch child.Type() {
+		case "using_directive":
+			if imp := extractUsing(child, content); imp != "" {
+				imports = append(imports, imp)
+			}
+		case "file_scoped_namespace_declaration":
+			// Declarations following "namespace Foo;" belong to it
+			fileNamespace = &Namespace{
+				name:       languages.FieldContent(child, "name", content),
+				fileScoped: true,
+				loc:        languages.NodeRange(child),
+			}
+			symbols = append(symbols, fileNamespace)
+		default:
+			for _, sym := range extractDeclaration(child, content) {
+				if fileNamespace == nil {
+					symbols = append(symbols, sym)
+					continue
+				}
+				languages.AddChild(fileNamespace, sym)
+				fileNamespace.loc.End = sym.Location().End
+			}
+		}
+	}
+
+	return imports, symbols, nil
+}
+
+// extractUsing extracts the namespace or type of a using directive.
+// Static and aliased usings are recorded by their target.
+func extractUsing(node *sitter.Node, content []byte) string {
+	alias := node.ChildByFieldName("name")
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if alias != nil && child.StartByte() == alias.StartByte() {
+			continue
+		}
+		switch child.Type() {
+		case "identifier", "qualified_name", "generic_name":
+			return child.Content(content)
+		}
+	}
+	return ""
+}
+
+// extractDeclaration extracts the symbols of a namespace, type or member declaration
+func extractDeclaration(node *sitter.Node, content []byte) []languages.Symbol {
+	switch node.Type() {
+	case "namespace_declaration":
+		ns := &Namespace{
+			name: languages.FieldContent(node, "name", content),
+			loc:  languages.NodeRange(node),
+		}
+		for _, sym := range extractBody(node.ChildByFieldName("body"), content) {
+			languages.AddChild(ns, sym)
+		}
+		return []languages.Symbol{ns}
+	case "class_declaration", "struct_declaration", "interface_declaration",
+		"enum_declaration", "record_declaration":
+		return []languages.Symbol{extractType(node, content)}
+	case "method_declaration", "constructor_declaration", "destructor_declaration",
+		"operator_declaration", "conversion_operator_declaration":
+		return []languages.Symbol{extractMethod(node, content)}
+	case "property_declaration", "indexer_declaration":
+		return []languages.Symbol{extractProperty(node, content)}
+	case "field_declaration", "event_field_declaration":
+		return extractFields(node, content)
+	case "event_declaration":
+		return []languages.Symbol{&Field{
+			name:      languages.FieldContent(node, "name", content),
+			kind:      "event",
+			modifiers: extractModifiers(node, content),
+			typeStr:   languages.Compact(languages.FieldContent(node, "type", content)),
+			doc:       extractDoc(node, content),
+			loc:       languages.NodeRange(node),
+		}}
+	case "delegate_declaration":
+		return []languages.Symbol{&Field{
+			name:      languages.FieldContent(node, "name", content),
+			kind:      "delegate",
+			modifiers: extractModifiers(node, content),
+			typeStr:   languages.Compact(languages.FieldContent(node, "type", content)),
+			params:    typeParams(node, content) + languages.Compact(languages.FieldContent(node, "parameters", content)),
+			doc:       extractDoc(node, content),
+			loc:       languages.NodeRange(node),
+		}}
+	}
+	return nil
+}
+
+// extractBody extracts the declarations of a namespace or type body
+func extractBody(body *sitter.Node, content []byte) []languages.Symbol {
+	if body == nil {
+		return nil
+	}
+	var symbols []languages.Symbol
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		symbols = append(symbols, extractDeclaration(body.NamedChild(i), content)...)
+	}
+	return symbols
+}
+
+// extractType extracts a class, struct, interface, enum or record with its members
+func extractType(node *sitter.Node, content []byte) languages.Symbol {
+	typ := &Type{
+		name:       languages.FieldContent(node, "name", content),
+		keyword:    strings.TrimSuffix(node.Type(), "_declaration"),
+		modifiers:  extractModifiers(node, content),
+		typeParams: typeParams(node, content),
+		doc:        extractDoc(node, content),
+		loc:        languages.NodeRange(node),
+	}
+
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		switch child.Type() {
+		case "struct":
+			if typ.keyword == "record" {
+				typ.keyword = "record struct"
+			}
+		case "parameter_list":
+			typ.params = languages.Compact(child.Content(content))
+		case "base_list":
+			for j := 0; j < int(child.NamedChildCount()); j++ {
+				typ.bases = append(typ.bases, languages.Compact(child.NamedChild(j).Content(content)))
+			}
+		}
+	}
+
+	body := node.ChildByFieldName("body")
+	if body == nil {
+		return typ
+	}
+
+	if body.Type() == "enum_member_declaration_list" {
+		for i := 0; i < int(body.NamedChildCount()); i++ {
+			child := body.NamedChild(i)
+			if child.Type() != "enum_member_declaration" {
+				continue
+			}
+			languages.AddChild(typ, &Field{
+				name: languages.FieldContent(child, "name", content),
+				kind: "constant",
+				doc:  extractDoc(child, content),
+				loc:  languages.NodeRange(child),
+			})
+		}
+		return typ
+	}
+
+	for _, member := range extractBody(body, content) {
+		languages.AddChild(typ, member)
+	}
+	return typ
+}
+
+// extractMethod extracts a method, constructor, destructor or operator
+func extractMethod(node *sitter.Node, content []byte) languages.Symbol {
+	method := &Method{
+		name:       languages.FieldContent(node, "name", content),
+		modifiers:  extractModifiers(node, content),
+		typeParams: typeParams(node, content),
+		params:     languages.Compact(languages.FieldContent(node, "parameters", content)),
+		doc:        extractDoc(node, content),
+		loc:        languages.NodeRange(node),
+	}
+
+	switch node.Type() {
+	case "method_declaration":
+		method.kind = "method"
+		method.returnType = languages.Compact(languages.FieldContent(node, "returns", content))
+	case "constructor_declaration":
+		method.kind = "constructor"
+	case "destructor_declaration":
+		method.kind = "destructor"
+		method.name = "~" + method.name
+	case "operator_declaration":
+		method.kind = "operator"
+		method.returnType = languages.Compact(languages.FieldContent(node, "type", content))
+		method.name = "operator " + languages.FieldContent(node, "operator", content)
+	case "conversion_operator_declaration":
+		// implicit operator int(Q q)
+		method.kind = "operator"
+		method.name = "operator " + languages.Compact(languages.FieldContent(node, "type", content))
+		for i := 0; i < int(node.ChildCount()); i++ {
+			if kw := node.Child(i).Type(); kw == "implicit" || kw == "explicit" {
+				method.modifiers = append(method.modifiers, kw)
+			}
+		}
+	}
+
+	return method
+}
+
+// extractProperty extracts a property or indexer
+func extractProperty(node *sitter.Node, content []byte) languages.Symbol {
+	prop := &Property{
+		name:      languages.FieldContent(node, "name", content),
+		modifiers: extractModifiers(node, content),
+		typeStr:   languages.Compact(languages.FieldContent(node, "type", content)),
+		doc:       extractDoc(node, content),
+		loc:       languages.NodeRange(node),
+	}
+
+	if node.Type() == "indexer_declaration" {
+		prop.name = "this"
+		prop.params = languages.Compact(languages.FieldContent(node, "parameters", content))
+	}
+
+	if accessors := node.ChildByFieldName("accessors"); accessors != nil {
+		var parts []string
+		for i := 0; i < int(accessors.NamedChildCount()); i++ {
+			accessor := accessors.NamedChild(i)
+			if accessor.Type() != "accessor_declaration" {
+				continue
+			}
+			mods := extractModifiers(accessor, content)
+			parts = append(parts, strings.Join(append(mods, languages.FieldContent(accessor, "name", content)), " ")+";")
+		}
+		prop.accessors = "{ " + strings.Join(parts, " ") + " }"
+	} else if node.ChildByFieldName("value") != nil {
+		// Expression-bodied: int X => 1;
+		prop.accessors = "{ get; }"
+	}
+
+	return prop
+}
+
+// extractFields extracts every variable declared by a field or event field declaration
+func extractFields(node *sitter.Node, content []byte) []languages.Symbol {
+	var fields []languages.Symbol
+
+	modifiers := extractModifiers(node, content)
+	doc := extractDoc(node, content)
+
+	kind := "field"
+	if node.Type() == "event_field_declaration" {
+		kind = "event"
+	}
+	for _, mod := range modifiers {
+		if mod == "const" {
+			kind = "constant"
+		}
+	}
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		decl := node.NamedChild(i)
+		if decl.Type() != "variable_declaration" {
+			continue
+		}
+		typeStr := languages.Compact(languages.FieldContent(decl, "type", content))
+		for j := 0; j < int(decl.NamedChildCount()); j++ {
+			child := decl.NamedChild(j)
+			if child.Type() != "variable_declarator" {
+				continue
+			}
+			fields = append(fields, &Field{
+				name:      languages.FieldContent(child, "name", content),
+				kind:      kind,
+				modifiers: modifiers,
+				typeStr:   typeStr,
+				doc:       doc,
+				loc:       languages.NodeRange(node),
+			})
+		}
+	}
+
+	return fields
+}
+
+// extractModifiers returns the attributes (without arguments) and modifier
+// keywords of a declaration, in source order
+func extractModifiers(node *sitter.Node, content []byte) []string {
+	var modifiers []string
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "modifier":
+			modifiers = append(modifiers, child.Content(content))
+		case "attribute_list":
+			var names []string
+			for j := 0; j < int(child.NamedChildCount()); j++ {
+				if attr := child.NamedChild(j); attr.Type() == "attribute" {
+					names = append(names, languages.FieldContent(attr, "name", content))
+				}
+			}
+			if len(names) > 0 {
+				modifiers = append(modifiers, "["+strings.Join(names, ", ")+"]")
+			}
+		}
+	}
+
+	return modifiers
+}
+
+// typeParams returns the type parameter list of a declaration (e.g., "<T>")
+func typeParams(node *sitter.Node, content []byte) string {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == "type_parameter_list" {
+			return languages.Compact(child.Content(content))
+		}
+	}
+	return ""
+}
+
+var (
+	// xmlRefPattern matches self-closing references such as <see cref="Foo"/>
+	xmlRefPattern = regexp.MustCompile(`<\w+\s+(?:cref|name|langword|href)="([^"]*)"\s*/>`)
+	// xmlTagPattern matches any remaining XML tag
+	xmlTagPattern = regexp.MustCompile(`</?\w+[^>]*>`)
+)
+
+// extractDoc extracts the first line of the summary of the XML doc comment
+// (/// lines) preceding a declaration, without the XML markup
+func extractDoc(node *sitter.Node, content []byte) string {
+	var lines []string
+	expectedRow := node.StartPoint().Row
+	for prev := node.PrevNamedSibling(); prev != nil && prev.Type() == "comment"; prev = prev.PrevNamedSibling() {
+		text := prev.Content(content)
+		if !strings.HasPrefix(text, "///") || prev.EndPoint().Row+1 != expectedRow {
+			break
+		}
+		lines = append([]string{strings.TrimPrefix(text, "///")}, lines...)
+		expectedRow = prev.StartPoint().Row
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+
+	text := strings.Join(lines, "\n")
+	if start := strings.Index(text, "<summary>"); start >= 0 {
+		text = text[start+len("<summary>"):]
+		if end := strings.Index(text, "</summary>"); end >= 0 {
+			text = text[:end]
+		}
+	}
+	text = xmlRefPattern.ReplaceAllString(text, "$1")
+	text = xmlTagPattern.ReplaceAllString(text, "")
+
+	for line := range strings.SplitSeq(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,324 @@
+package csharp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+	"github.com/roveo/topo-mcp/languages/languagetest"
+)
+
+func TestLanguageMetadata(t *testing.T) {
+	lang := &Language{}
+
+	if lang.Name() != "csharp" {
+		t.Errorf("expected name 'csharp', got %q", lang.Name())
+	}
+
+	exts := lang.Extensions()
+	if len(exts) != 1 || exts[0] != ".cs" {
+		t.Errorf("expected extensions [.cs], got %v", exts)
+	}
+}
+
+func TestParseUsings(t *testing.T) {
+	src := `using System;
+using System.Collections.Generic;
+using static System.Math;
+using Builder = System.Text.StringBuilder;
+`
+	lang := &Language{}
+	imports, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	expected := []string{"System", "System.Collections.Generic", "System.Math", "System.Text.StringBuilder"}
+	if strings.Join(imports, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected imports %v, got %v", expected, imports)
+	}
+	if len(symbols) != 0 {
+		t.Errorf("expected no symbols, got %d", len(symbols))
+	}
+}
+
+func TestParseClass(t *testing.T) {
+	src := `namespace App.Services
+{
+    /// <summary>
+    /// Handles incoming requests.
+    /// </summary>
+    [Serializable, Obsolete("use V2")]
+    public sealed partial class Server<T> : Base, IDisposable where T : class
+    {
+        private const int DefaultPort = 80;
+        private readonly int _port, _backlog;
+        public event EventHandler Started;
+        public string Name { get; private set; }
+        public bool Running => _running;
+        public int this[int i] => i;
+
+        public Server(int port) : base(port) { _port = port; }
+        ~Server() { }
+
+        /// <summary>Starts the <see cref="Server{T}"/>.</summary>
+        /// <param name="ct">Cancellation token</param>
+        public async Task<bool> StartAsync<R>(CancellationToken ct = default) where R : new()
+        {
+            return true;
+        }
+
+        public static Server<T> operator +(Server<T> a, Server<T> b) => a;
+        public delegate void Callback<TArg>(TArg arg);
+        private class Inner { }
+    }
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 symbol, got %d", len(symbols))
+	}
+
+	ns, ok := symbols[0].(*Namespace)
+	if !ok {
+		t.Fatalf("expected *Namespace, got %T", symbols[0])
+	}
+	if ns.String() != "namespace App.Services" {
+		t.Errorf("unexpected String(): %q", ns.String())
+	}
+
+	cls := ns.Children()[0].(*Type)
+	want := "[Serializable, Obsolete] public sealed partial class Server<T> : Base, IDisposable"
+	if cls.String() != want {
+		t.Errorf("unexpected String():\ngot:  %q\nwant: %q", cls.String(), want)
+	}
+	if cls.DocComment() != "Handles incoming requests." {
+		t.Errorf("expected doc comment 'Handles incoming requests.', got %q", cls.DocComment())
+	}
+
+	expected := []string{
+		"private const int DefaultPort",
+		"private readonly int _port",
+		"private readonly int _backlog",
+		"public event EventHandler Started",
+		"public string Name { get; private set; }",
+		"public bool Running { get; }",
+		"public int this[int i] { get; }",
+		"public Server(int port)",
+		"~Server()",
+		"public async Task<bool> StartAsync<R>(CancellationToken ct = default)",
+		"public static Server<T> operator +(Server<T> a, Server<T> b)",
+		"public delegate void Callback<TArg>(TArg arg)",
+		"private class Inner",
+	}
+	got := languagetest.ChildStrings(cls)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected members:\ngot:  %q\nwant: %q", got, expected)
+	}
+
+	kinds := []string{"constant", "field", "field", "event", "property", "property", "indexer",
+		"constructor", "destructor", "method", "operator", "delegate", "class"}
+	for i, m := range cls.Children() {
+		if m.Kind() != kinds[i] {
+			t.Errorf("member %q: expected kind %q, got %q", m.Name(), kinds[i], m.Kind())
+		}
+	}
+
+	start := cls.Children()[9].(*Method)
+	if start.Name() != "StartAsync" {
+		t.Errorf("expected name 'StartAsync', got %q", start.Name())
+	}
+	if start.DocComment() != "Starts the Server{T}." {
+		t.Errorf("expected doc comment 'Starts the Server{T}.', got %q", start.DocComment())
+	}
+	if loc := start.Location(); loc.Start.Line != 20 || loc.End.Line != 23 {
+		t.Errorf("expected StartAsync on lines 20-23, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+
+	if callback := cls.Children()[11]; callback.Name() != "Callback" {
+		t.Errorf("expected delegate name 'Callback', got %q", callback.Name())
+	}
+}
+
+func TestParseInterfaceEnumRecordStruct(t *testing.T) {
+	src := `public interface IRepository<T> : IDisposable
+{
+    T Find(int id);
+    int Count { get; }
+    event Action Changed;
+}
+
+/// Primary colors
+public enum Color : byte
+{
+    /// <summary>Pure red</summary>
+    Red,
+    Green = 2,
+}
+
+public record Point(int X, int Y);
+
+public readonly record struct Size(int Width, int Height);
+
+public struct Vector
+{
+    public float X, Y;
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	tests := []struct {
+		kind    string
+		str     string
+		members []string
+	}{
+		{"interface", "public interface IRepository<T> : IDisposable", []string{"T Find(int id)", "int Count { get; }", "event Action Changed"}},
+		{"enum", "public enum Color : byte", []string{"Red", "Green"}},
+		{"record", "public record Point(int X, int Y)", nil},
+		{"record", "public readonly record struct Size(int Width, int Height)", nil},
+		{"struct", "public struct Vector", []string{"public float X", "public float Y"}},
+	}
+
+	if len(symbols) != len(tests) {
+		t.Fatalf("expected %d symbols, got %d", len(tests), len(symbols))
+	}
+	for i, tt := range tests {
+		typ := symbols[i].(*Type)
+		if typ.Kind() != tt.kind {
+			t.Errorf("%s: expected kind %q, got %q", typ.Name(), tt.kind, typ.Kind())
+		}
+		if typ.String() != tt.str {
+			t.Errorf("unexpected String(): got %q, want %q", typ.String(), tt.str)
+		}
+		if got := languagetest.ChildStrings(typ); strings.Join(got, "\n") != strings.Join(tt.members, "\n") {
+			t.Errorf("%s: unexpected members %q, want %q", typ.Name(), got, tt.members)
+		}
+	}
+
+	color := symbols[1].(*Type)
+	if color.DocComment() != "Primary colors" {
+		t.Errorf("expected doc comment 'Primary colors', got %q", color.DocComment())
+	}
+	red := color.Children()[0].(*Field)
+	if red.Kind() != "constant" || red.DocComment() != "Pure red" {
+		t.Errorf("expected constant Red with doc 'Pure red', got %s %q", red.Kind(), red.DocComment())
+	}
+}
+
+func TestParseFileScopedNamespace(t *testing.T) {
+	src := `using System;
+
+namespace App.Models;
+
+public class User
+{
+    public int Id { get; init; }
+}
+
+public record Role(string Name);
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 symbol, got %d", len(symbols))
+	}
+
+	ns := symbols[0].(*Namespace)
+	if ns.String() != "namespace App.Models;" {
+		t.Errorf("unexpected String(): %q", ns.String())
+	}
+	if got := languagetest.ChildStrings(ns); strings.Join(got, ",") != "public class User,public record Role(string Name)" {
+		t.Errorf("unexpected namespace members: %q", got)
+	}
+	if loc := ns.Location(); loc.Start.Line != 2 || loc.End.Line != 9 {
+		t.Errorf("expected namespace on lines 2-9, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+
+	user := ns.Children()[0]
+	if got := languages.QualifiedName(languages.ChildrenOf(user)[0]); got != "App.Models.User.Id" {
+		t.Errorf("expected qualified name 'App.Models.User.Id', got %q", got)
+	}
+}
+
+func TestParseNestedNamespaces(t *testing.T) {
+	src := `namespace Outer
+{
+    namespace Inner
+    {
+        internal static class Helpers
+        {
+            public static string Trim(string s) => s.Trim();
+        }
+    }
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	found := languages.Lookup(symbols, "Outer.Inner.Helpers.Trim")
+	if len(found) != 1 {
+		t.Fatalf("expected to find Outer.Inner.Helpers.Trim, got %d matches", len(found))
+	}
+	if found[0].String() != "public static string Trim(string s)" {
+		t.Errorf("unexpected String(): %q", found[0].String())
+	}
+}
+
+func TestNamespaceSelectors(t *testing.T) {
+	src := `namespace App.Billing;
+
+public class Invoice
+{
+    public decimal Total { get; set; }
+
+    public void Pay() { }
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	tests := []struct {
+		selector string
+		want     string
+	}{
+		{"Invoice", "public class Invoice"},
+		{"Invoice.Total", "public decimal Total { get; set; }"},
+		{"Invoice.Pay", "public void Pay()"},
+		{"App.Billing.Invoice.Pay", "public void Pay()"},
+	}
+	for _, tt := range tests {
+		found := languages.Lookup(symbols, tt.selector)
+		if len(found) != 1 {
+			t.Errorf("%s: expected 1 match, got %d", tt.selector, len(found))
+			continue
+		}
+		if found[0].String() != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.selector, tt.want, found[0].String())
+		}
+	}
+
+	// IDs keep the namespace: the qualified path is the first selector
+	pay := languages.Lookup(symbols, "Invoice.Pay")[0]
+	if sel := languages.Selectors(pay); sel[0] != "App.Billing.Invoice.Pay" {
+		t.Errorf("expected first selector 'App.Billing.Invoice.Pay', got %v", sel)
+	}
+}
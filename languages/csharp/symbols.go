@@ -0,0 +1,189 @@
+package csharp
+
+import (
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Namespace represents a block or file-scoped namespace. Its types are its children.
+type Namespace struct {
+	languages.Nesting
+	name       string
+	fileScoped bool
+	loc        languages.Range
+}
+
+func (n *Namespace) Name() string              { return n.name }
+func (n *Namespace) Kind() string              { return "namespace" }
+func (n *Namespace) Location() languages.Range { return n.loc }
+func (n *Namespace) String() string {
+	if n.fileScoped {
+		return "namespace " + n.name + ";"
+	}
+	return "namespace " + n.name
+}
+
+// Type represents a class, struct, interface, enum or record.
+// Members and nested types are its children.
+type Type struct {
+	languages.Nesting
+	name       string
+	keyword    string // "class", "struct", "interface", "enum", "record" or "record struct"
+	modifiers  []string
+	typeParams string // e.g., "<T>"
+	params     string // Primary constructor of a record (e.g., "(int X, int Y)")
+	bases      []string
+	doc        string
+	loc        languages.Range
+}
+
+func (t *Type) Name() string { return t.name }
+func (t *Type) Kind() string {
+	if t.keyword == "record struct" {
+		return "record"
+	}
+	return t.keyword
+}
+func (t *Type) Location() languages.Range { return t.loc }
+func (t *Type) String() string {
+	var sb strings.Builder
+	writeModifiers(&sb, t.modifiers)
+	sb.WriteString(t.keyword)
+	sb.WriteString(" ")
+	sb.WriteString(t.name)
+	sb.WriteString(t.typeParams)
+	sb.WriteString(t.params)
+	if len(t.bases) > 0 {
+		sb.WriteString(" : ")
+		sb.WriteString(strings.Join(t.bases, ", "))
+	}
+	return sb.String()
+}
+func (t *Type) DocComment() string  { return t.doc }
+func (t *Type) Selectors() []string { return namespaceSelectors(t) }
+
+// Method represents a method, constructor, destructor or operator
+type Method struct {
+	languages.Nesting
+	name       string
+	kind       string // "method", "constructor", "destructor" or "operator"
+	modifiers  []string
+	returnType string // Empty for constructors and destructors
+	typeParams string // e.g., "<R>"
+	params     string // e.g., "(string name, int n = 0)"
+	doc        string
+	loc        languages.Range
+}
+
+func (m *Method) Name() string              { return m.name }
+func (m *Method) Kind() string              { return m.kind }
+func (m *Method) Location() languages.Range { return m.loc }
+func (m *Method) String() string {
+	var sb strings.Builder
+	writeModifiers(&sb, m.modifiers)
+	if m.returnType != "" {
+		sb.WriteString(m.returnType)
+		sb.WriteString(" ")
+	}
+	sb.WriteString(m.name)
+	sb.WriteString(m.typeParams)
+	sb.WriteString(m.params)
+	return sb.String()
+}
+func (m *Method) DocComment() string  { return m.doc }
+func (m *Method) Selectors() []string { return namespaceSelectors(m) }
+
+// Property represents a property or an indexer
+type Property struct {
+	languages.Nesting
+	name      string // "this" for indexers
+	modifiers []string
+	typeStr   string
+	params    string // Indexer parameters (e.g., "[int i]")
+	accessors string // e.g., "{ get; private set; }"
+	doc       string
+	loc       languages.Range
+}
+
+func (p *Property) Name() string { return p.name }
+func (p *Property) Kind() string {
+	if p.params != "" {
+		return "indexer"
+	}
+	return "property"
+}
+func (p *Property) Location() languages.Range { return p.loc }
+func (p *Property) String() string {
+	var sb strings.Builder
+	writeModifiers(&sb, p.modifiers)
+	sb.WriteString(p.typeStr)
+	sb.WriteString(" ")
+	sb.WriteString(p.name)
+	sb.WriteString(p.params)
+	if p.accessors != "" {
+		sb.WriteString(" ")
+		sb.WriteString(p.accessors)
+	}
+	return sb.String()
+}
+func (p *Property) DocComment() string  { return p.doc }
+func (p *Property) Selectors() []string { return namespaceSelectors(p) }
+
+// Field represents a field, event, enum member or delegate
+type Field struct {
+	languages.Nesting
+	name      string
+	kind      string // "field", "constant", "event" or "delegate"
+	modifiers []string
+	typeStr   string // Field or event type, delegate return type; empty for enum members
+	params    string // Delegate type parameters and parameters (e.g., "<T>(T item)")
+	doc       string
+	loc       languages.Range
+}
+
+func (f *Field) Name() string              { return f.name }
+func (f *Field) Kind() string              { return f.kind }
+func (f *Field) Location() languages.Range { return f.loc }
+func (f *Field) String() string {
+	var sb strings.Builder
+	writeModifiers(&sb, f.modifiers)
+	switch f.kind {
+	case "event":
+		sb.WriteString("event ")
+	case "delegate":
+		sb.WriteString("delegate ")
+	}
+	if f.typeStr != "" {
+		sb.WriteString(f.typeStr)
+		sb.WriteString(" ")
+	}
+	sb.WriteString(f.name)
+	sb.WriteString(f.params)
+	return sb.String()
+}
+func (f *Field) DocComment() string  { return f.doc }
+func (f *Field) Selectors() []string { return namespaceSelectors(f) }
+
+// writeModifiers writes attributes and modifier keywords followed by a space
+func writeModifiers(sb *strings.Builder, modifiers []string) {
+	for _, mod := range modifiers {
+		sb.WriteString(mod)
+		sb.WriteString(" ")
+	}
+}
+
+// namespaceSelectors returns the path of a symbol declared in a namespace
+// both with and without the namespace ("App.Billing.Invoice.Total" and
+// "Invoice.Total"), as code importing the namespace refers to it. Symbols
+// outside of namespaces are addressed by their path alone.
+func namespaceSelectors(sym languages.Symbol) []string {
+	var local []string
+	for s := sym; s != nil; s = languages.ParentOf(s) {
+		if _, ok := s.(*Namespace); ok {
+			return []string{languages.QualifiedName(sym), strings.Join(local, ".")}
+		}
+		local = append([]string{s.Name()}, local...)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package dockerfile
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/dockerfile"
+)
+
+func init() {
+	languages.Register(&Language{})
+}
+
+// Language implements the Dockerfile parser
+type Language struct{}
+
+func (l *Language) Name() string { return "dockerfile" }
+
+// Extensions returns ".dockerfile" for files such as "app.Dockerfile"
+// (extensions are matched case-insensitively)
+func (l *Language) Extensions() []string { return []string{".dockerfile"} }
+func (l *Language) Filenames() []string  { return []string{"Dockerfile", "Containerfile"} }
+
+// Globs returns patterns for variants such as "Dockerfile.dev"
+func (l *Language) Globs() []string { return []string{"Dockerfile.*", "Containerfile.*"} }
+
+func (l *Language) TreeSitterLang() *sitter.Language {
+	return dockerfile.GetLanguage()
+}
+
+// parserDirective matches the directives at the top of a Dockerfile (e.g., "# syntax=docker/dockerfile:1")
+var parserDirective = regexp.MustCompile(`^#\s*(syntax|escape|check)\s*=`)
+
+// Parse extracts build stages and ARGs. Base images that are not earlier
+// stages are returned as imports.
+func (l *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(dockerfile.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Dockerfile: %w", err)
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	var imports []string
+	var symbols []languages.Symbol
+	var stage *Stage
+	stages := make(map[string]bool)
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		node := root.NamedChild(i)
+		switch node.Type() {
+		case "comment":
+			continue
+		case "from_instruction":
+			stage = &Stage{
+				name: strconv.Itoa(len(stages)),
+				from: compact(node.Content(content)),
+				doc:  extractDoc(node, content),
+				loc:  languages.NodeRange(node),
+			}
+			if alias := node.ChildByFieldName("as"); alias != nil {
+				stage.name = alias.Content(content)
+			}
+			if image := childOfType(node, "image_spec"); image != nil {
+				name := image.Content(content)
+				if nameNode := image.ChildByFieldName("name"); nameNode != nil && !stages[nameNode.Content(content)] {
+					imports = append(imports, name)
+				}
+			}
+			stages[stage.name] = true
+			symbols = append(symbols, stage)
+			continue
+		case "arg_instruction":
+			arg := &Arg{
+				name: languages.FieldContent(node, "name", content),
+				// Defaults may be quoted or contain expansions, so keep them as written
+				value: languages.FieldContent(node, "default", content),
+				doc:   extractDoc(node, content),
+				loc:   languages.NodeRange(node),
+			}
+			if stage == nil {
+				symbols = append(symbols, arg)
+			} else {
+				languages.AddChild(stage, arg)
+			}
+		}
+
+		// Every instruction extends the current stage
+		if stage != nil {
+			stage.loc.End = languages.NodeRange(node).End
+		}
+	}
+
+	return imports, symbols, nil
+}
+
+// childOfType returns the first named child of the given type, or nil
+func childOfType(node *sitter.Node, typ string) *sitter.Node {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == typ {
+			return child
+		}
+	}
+	return nil
+}
+
+// compact returns an instruction on one line, without line continuations
+func compact(s string) string {
+	return languages.Compact(strings.ReplaceAll(s, "\\\n", " "))
+}
+
+// extractDoc extracts the first line of the # comments directly preceding an
+// instruction. Parser directives are not documentation.
+func extractDoc(node *sitter.Node, content []byte) string {
+	var lines []string
+	expectedRow := node.StartPoint().Row
+	for prev := node.PrevNamedSibling(); prev != nil && prev.Type() == "comment"; prev = prev.PrevNamedSibling() {
+		if prev.EndPoint().Row+1 != expectedRow {
+			break
+		}
+		text := prev.Content(content)
+		if parserDirective.MatchString(text) {
+			break
+		}
+		lines = append([]string{strings.TrimPrefix(text, "#")}, lines...)
+		expectedRow = prev.StartPoint().Row
+	}
+
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
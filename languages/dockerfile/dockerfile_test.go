@@ -0,0 +1,113 @@
+package dockerfile
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+func TestLanguageMetadata(t *testing.T) {
+	lang := &Language{}
+
+	if lang.Name() != "dockerfile" {
+		t.Errorf("expected name 'dockerfile', got %q", lang.Name())
+	}
+
+	exts := lang.Extensions()
+	if len(exts) != 1 || exts[0] != ".dockerfile" {
+		t.Errorf("expected extensions [.dockerfile], got %v", exts)
+	}
+
+	names := lang.Filenames()
+	if strings.Join(names, ",") != "Dockerfile,Containerfile" {
+		t.Errorf("expected filenames [Dockerfile Containerfile], got %v", names)
+	}
+}
+
+func TestParse(t *testing.T) {
+	src := `# syntax=docker/dockerfile:1
+ARG GO_VERSION=1.25
+
+# Build the binary
+FROM golang:${GO_VERSION} AS build
+WORKDIR /src
+RUN go mod download \
+    && echo done
+COPY . .
+RUN go build -o /bin/app .
+
+FROM build as test
+RUN go test ./...
+
+# Runtime image
+FROM gcr.io/distroless/static
+# Version reported by the binary
+ARG VERSION
+COPY --from=build /bin/app /app
+ENTRYPOINT ["/app"]
+`
+	lang := &Language{}
+	imports, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	// The test stage builds on an earlier stage, not an image
+	want := []string{"golang:${GO_VERSION}", "gcr.io/distroless/static"}
+	if strings.Join(imports, ",") != strings.Join(want, ",") {
+		t.Errorf("expected imports %v, got %v", want, imports)
+	}
+
+	tests := []struct {
+		name      string
+		kind      string
+		str       string
+		doc       string
+		startLine int
+		endLine   int
+	}{
+		{"GO_VERSION", "arg", "ARG GO_VERSION=1.25", "", 1, 1},
+		{"build", "stage", "FROM golang:${GO_VERSION} AS build", "Build the binary", 4, 9},
+		{"test", "stage", "FROM build as test", "", 11, 12},
+		{"2", "stage", "FROM gcr.io/distroless/static", "Runtime image", 15, 19},
+	}
+
+	if len(symbols) != len(tests) {
+		for _, s := range symbols {
+			t.Logf("  %s: %s", s.Kind(), s.String())
+		}
+		t.Fatalf("expected %d symbols, got %d", len(tests), len(symbols))
+	}
+
+	for i, tt := range tests {
+		sym := symbols[i]
+		if sym.Name() != tt.name {
+			t.Errorf("symbol %d: expected name %q, got %q", i, tt.name, sym.Name())
+		}
+		if sym.Kind() != tt.kind {
+			t.Errorf("%s: expected kind %q, got %q", tt.name, tt.kind, sym.Kind())
+		}
+		if sym.String() != tt.str {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.str, sym.String())
+		}
+		if doc := sym.(languages.Documented).DocComment(); doc != tt.doc {
+			t.Errorf("%s: expected doc %q, got %q", tt.name, tt.doc, doc)
+		}
+		loc := sym.Location()
+		if loc.Start.Line != tt.startLine || loc.End.Line != tt.endLine {
+			t.Errorf("%s: expected lines %d-%d, got %d-%d", tt.name, tt.startLine, tt.endLine, loc.Start.Line, loc.End.Line)
+		}
+	}
+
+	args := languages.ChildrenOf(symbols[3])
+	if len(args) != 1 || args[0].String() != "ARG VERSION" {
+		t.Fatalf("expected runtime stage to have ARG VERSION, got %v", args)
+	}
+	if args[0].(*Arg).DocComment() != "Version reported by the binary" {
+		t.Errorf("expected ARG VERSION doc, got %q", args[0].(*Arg).DocComment())
+	}
+	if languages.QualifiedName(args[0]) != "2.VERSION" {
+		t.Errorf("expected qualified name 2.VERSION, got %q", languages.QualifiedName(args[0]))
+	}
+}
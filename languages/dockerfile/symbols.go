@@ -0,0 +1,44 @@
+package dockerfile
+
+import (
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Stage represents a build stage, from its FROM instruction to the last
+// instruction before the next one. Its ARGs are its children.
+//
+// Stages are named by their alias (FROM ... AS build), or by their index
+// when they have none, as docker refers to them (COPY --from=0).
+type Stage struct {
+	languages.Nesting
+	name string
+	from string // FROM instruction as written (e.g., "FROM golang:1.25 AS build")
+	doc  string
+	loc  languages.Range
+}
+
+func (s *Stage) Name() string              { return s.name }
+func (s *Stage) Kind() string              { return "stage" }
+func (s *Stage) Location() languages.Range { return s.loc }
+func (s *Stage) String() string            { return s.from }
+func (s *Stage) DocComment() string        { return s.doc }
+
+// Arg represents an ARG instruction. ARGs before the first FROM are global.
+type Arg struct {
+	languages.Nesting
+	name  string
+	value string // Default value as written, if any
+	doc   string
+	loc   languages.Range
+}
+
+func (a *Arg) Name() string              { return a.name }
+func (a *Arg) Kind() string              { return "arg" }
+func (a *Arg) Location() languages.Range { return a.loc }
+func (a *Arg) String() string {
+	if a.value == "" {
+		return "ARG " + a.name
+	}
+	return "ARG " + a.name + "=" + a.value
+}
+func (a *Arg) DocComment() string { return a.doc }
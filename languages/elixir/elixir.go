@@ -0,0 +1,267 @@
+package elixir
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/elixir"
+)
+
+func init() {
+	languages.Register(&Language{})
+}
+
+// Language implements the Elixir language parser
+type Language struct{}
+
+func (l *Language) Name() string           { return "elixir" }
+func (l *Language) Extensions() []string   { return []string{".ex", ".exs"} }
+func (l *Language) Interpreters() []string { return []string{"elixir"} }
+
+func (l *Language) TreeSitterLang() *sitter.Language {
+	return elixir.GetLanguage()
+}
+
+func (l *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(elixir.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Elixir file: %w", err)
+	}
+	defer tree.Close()
+
+	var imports []string
+	symbols := extractBody(tree.RootNode(), content, &imports)
+
+	return imports, symbols, nil
+}
+
+// extractBody extracts the modules and functions defined in a source file or
+// a module's do block, and collects the modules it uses, imports, aliases or requires
+func extractBody(body *sitter.Node, content []byte, imports *[]string) []languages.Symbol {
+	var symbols []languages.Symbol
+
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		child := body.NamedChild(i)
+		if child.Type() != "call" {
+			continue
+		}
+		switch macro := callTarget(child, content); macro {
+		case "defmodule", "defprotocol", "defimpl":
+			if module := extractModule(child, macro, content, imports); module != nil {
+				symbols = append(symbols, module)
+			}
+		case "def", "defp", "defmacro", "defmacrop":
+			fn := extractFunction(child, macro, content)
+			if fn == nil {
+				continue
+			}
+			// Further clauses of the previous function extend it
+			if len(symbols) > 0 {
+				if prev, ok := symbols[len(symbols)-1].(*Function); ok &&
+					prev.name == fn.name && prev.arity == fn.arity && prev.kind == fn.kind {
+					prev.loc.End = fn.loc.End
+					continue
+				}
+			}
+			symbols = append(symbols, fn)
+		case "use", "import", "alias", "require":
+			*imports = append(*imports, aliases(child, content)...)
+		}
+	}
+
+	return symbols
+}
+
+// extractModule extracts a module, protocol or protocol implementation with
+// its functions and nested modules
+func extractModule(node *sitter.Node, macro string, content []byte, imports *[]string) *Module {
+	args := languages.FirstChildOfType(node, "arguments")
+	if args == nil {
+		return nil
+	}
+	alias := languages.FirstChildOfType(args, "alias")
+	if alias == nil {
+		return nil
+	}
+
+	module := &Module{
+		name:   alias.Content(content),
+		kind:   "module",
+		header: macro + " " + alias.Content(content),
+		loc:    languages.NodeRange(node),
+	}
+	switch macro {
+	case "defprotocol":
+		module.kind = "protocol"
+	case "defimpl":
+		module.kind = "impl"
+		module.header = macro + " " + languages.Compact(args.Content(content))
+		if target := keywordValue(args, "for", content); target != nil {
+			module.name += "." + target.Content(content)
+		}
+	}
+
+	body := languages.FirstChildOfType(node, "do_block")
+	if body == nil {
+		return module
+	}
+	module.doc = attributeDoc(body, "moduledoc", content)
+	for _, child := range extractBody(body, content, imports) {
+		languages.AddChild(module, child)
+	}
+	return module
+}
+
+// extractFunction extracts a function or macro clause. Returns nil for
+// definitions with a computed name (def unquote(name)()).
+func extractFunction(node *sitter.Node, macro string, content []byte) *Function {
+	args := languages.FirstChildOfType(node, "arguments")
+	if args == nil || args.NamedChildCount() == 0 {
+		return nil
+	}
+	head := args.NamedChild(0)
+
+	// def name(args) when guard
+	signature := head
+	if signature.Type() == "binary_operator" {
+		if op := signature.ChildByFieldName("operator"); op != nil && op.Content(content) == "when" {
+			signature = signature.ChildByFieldName("left")
+		}
+	}
+
+	fn := &Function{
+		kind:   macro,
+		header: macro + " " + languages.Compact(head.Content(content)),
+		doc:    extractDoc(node, content),
+		loc:    languages.NodeRange(node),
+	}
+	switch signature.Type() {
+	case "identifier":
+		fn.name = signature.Content(content)
+	case "call":
+		target := signature.ChildByFieldName("target")
+		if target == nil || target.Type() != "identifier" {
+			return nil
+		}
+		fn.name = target.Content(content)
+		if params := languages.FirstChildOfType(signature, "arguments"); params != nil {
+			fn.arity = int(params.NamedChildCount())
+		}
+	default:
+		return nil
+	}
+	return fn
+}
+
+// aliases returns the modules named by a use, import, alias or require
+// call, expanding multi-aliases (alias Notify.{Repo, User})
+func aliases(node *sitter.Node, content []byte) []string {
+	args := languages.FirstChildOfType(node, "arguments")
+	if args == nil || args.NamedChildCount() == 0 {
+		return nil
+	}
+	switch first := args.NamedChild(0); first.Type() {
+	case "alias":
+		return []string{first.Content(content)}
+	case "dot":
+		left, right := first.ChildByFieldName("left"), first.ChildByFieldName("right")
+		if left == nil || right == nil || right.Type() != "tuple" {
+			return nil
+		}
+		var modules []string
+		for i := 0; i < int(right.NamedChildCount()); i++ {
+			if alias := right.NamedChild(i); alias.Type() == "alias" {
+				modules = append(modules, left.Content(content)+"."+alias.Content(content))
+			}
+		}
+		return modules
+	}
+	return nil
+}
+
+// callTarget returns the name of the function or macro a call invokes
+// (e.g., "defmodule"), or "" for remote and anonymous calls
+func callTarget(node *sitter.Node, content []byte) string {
+	if target := node.ChildByFieldName("target"); target != nil && target.Type() == "identifier" {
+		return target.Content(content)
+	}
+	return ""
+}
+
+// keywordValue returns the value of a keyword argument (e.g., for: in
+// defimpl), or nil
+func keywordValue(args *sitter.Node, key string, content []byte) *sitter.Node {
+	keywords := languages.FirstChildOfType(args, "keywords")
+	if keywords == nil {
+		return nil
+	}
+	for i := 0; i < int(keywords.NamedChildCount()); i++ {
+		pair := keywords.NamedChild(i)
+		if k := pair.ChildByFieldName("key"); k != nil && strings.TrimSuffix(strings.TrimSpace(k.Content(content)), ":") == key {
+			return pair.ChildByFieldName("value")
+		}
+	}
+	return nil
+}
+
+// extractDoc returns the @doc of a function clause, looking back over the
+// attributes (@doc, @spec, @impl) and comments directly before it
+func extractDoc(node *sitter.Node, content []byte) string {
+	for prev := node.PrevNamedSibling(); prev != nil; prev = prev.PrevNamedSibling() {
+		switch {
+		case prev.Type() == "comment":
+		case prev.Type() == "unary_operator" && strings.HasPrefix(prev.Content(content), "@"):
+			if operand := prev.ChildByFieldName("operand"); operand != nil && callTarget(operand, content) == "doc" {
+				return docString(operand, content)
+			}
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
+// attributeDoc returns the documentation of a module attribute (e.g.,
+// @moduledoc) declared directly in a body
+func attributeDoc(body *sitter.Node, attribute string, content []byte) string {
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		child := body.NamedChild(i)
+		if child.Type() != "unary_operator" {
+			continue
+		}
+		if operand := child.ChildByFieldName("operand"); operand != nil && callTarget(operand, content) == attribute {
+			return docString(operand, content)
+		}
+	}
+	return ""
+}
+
+// docString returns the first line of the string passed to @doc or
+// @moduledoc, or "" for @doc false
+func docString(attr *sitter.Node, content []byte) string {
+	args := languages.FirstChildOfType(attr, "arguments")
+	if args == nil || args.NamedChildCount() == 0 {
+		return ""
+	}
+	value := args.NamedChild(0)
+	if value.Type() != "string" && value.Type() != "sigil" {
+		return ""
+	}
+	text := languages.FirstChildOfType(value, "quoted_content")
+	if text == nil {
+		return ""
+	}
+	for line := range strings.SplitSeq(text.Content(content), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
@@ -0,0 +1,159 @@
+package elixir
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+	"github.com/roveo/topo-mcp/languages/languagetest"
+)
+
+func TestLanguageMetadata(t *testing.T) {
+	lang := &Language{}
+
+	if lang.Name() != "elixir" {
+		t.Errorf("expected name 'elixir', got %q", lang.Name())
+	}
+
+	exts := lang.Extensions()
+	if strings.Join(exts, ",") != ".ex,.exs" {
+		t.Errorf("expected extensions [.ex .exs], got %v", exts)
+	}
+}
+
+func TestParseModule(t *testing.T) {
+	src := `defmodule Notify.Mailer do
+  @moduledoc """
+  Sends notification emails.
+  """
+  use GenServer
+  import Ecto.Query, only: [from: 2]
+  alias Notify.{Repo, User}
+
+  @doc "Delivers an email."
+  @spec deliver(User.t(), keyword()) :: :ok
+  def deliver(%User{} = user, opts \\ []) do
+    :ok
+  end
+
+  def deliver(nil, _), do: {:error, :no_user}
+
+  # Renders a template
+  defp render(template) when is_binary(template), do: template
+
+  @doc false
+  defmacro with_retry(do: block) do
+    quote do: unquote(block)
+  end
+
+  defmacrop debug(msg), do: msg
+
+  defmodule Inner do
+    def ping, do: :pong
+  end
+end
+`
+	lang := &Language{}
+	imports, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if got := strings.Join(imports, ","); got != "GenServer,Ecto.Query,Notify.Repo,Notify.User" {
+		t.Errorf("unexpected imports: %v", imports)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 module, got %d", len(symbols))
+	}
+	module := symbols[0].(*Module)
+	if module.Kind() != "module" || module.Name() != "Notify.Mailer" || module.String() != "defmodule Notify.Mailer" {
+		t.Errorf("expected module Notify.Mailer, got %s %q", module.Kind(), module.String())
+	}
+	if module.DocComment() != "Sends notification emails." {
+		t.Errorf("expected moduledoc 'Sends notification emails.', got %q", module.DocComment())
+	}
+
+	tests := []struct {
+		kind string
+		str  string
+		doc  string
+	}{
+		{"def", `def deliver(%User{} = user, opts \\ [])`, "Delivers an email."},
+		{"defp", "defp render(template) when is_binary(template)", ""},
+		{"defmacro", "defmacro with_retry(do: block)", ""},
+		{"defmacrop", "defmacrop debug(msg)", ""},
+		{"module", "defmodule Inner", ""},
+	}
+	children := module.Children()
+	if len(children) != len(tests) {
+		t.Fatalf("expected %d children, got %d: %v", len(tests), len(children), languagetest.ChildStrings(module))
+	}
+	for i, tt := range tests {
+		child := children[i]
+		if child.Kind() != tt.kind || child.String() != tt.str {
+			t.Errorf("expected %s %q, got %s %q", tt.kind, tt.str, child.Kind(), child.String())
+		}
+		if doc := child.(languages.Documented).DocComment(); doc != tt.doc {
+			t.Errorf("%s: expected doc comment %q, got %q", child.Name(), tt.doc, doc)
+		}
+	}
+
+	// Both clauses of deliver/2 form one function
+	deliver := children[0]
+	if loc := deliver.Location(); loc.Start.Line != 10 || loc.End.Line != 14 {
+		t.Errorf("expected deliver on lines 10-14, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+
+	selectors := []string{"deliver/2", "Notify.Mailer.deliver/2", "Notify.Mailer.Inner.ping/0", "Notify.Mailer.Inner.ping"}
+	for _, selector := range selectors {
+		if found := languages.Lookup(symbols, selector); len(found) != 1 {
+			t.Errorf("expected to find %s, got %d matches", selector, len(found))
+		}
+	}
+	if found := languages.Lookup(symbols, "deliver/1"); len(found) != 0 {
+		t.Errorf("expected no deliver/1, got %d matches", len(found))
+	}
+}
+
+func TestParseProtocol(t *testing.T) {
+	src := `defprotocol Notify.Channel do
+  @doc "Sends a message"
+  def send(channel, msg)
+end
+
+defimpl Notify.Channel, for: Notify.Slack do
+  def send(_, msg), do: msg
+end
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	tests := []struct {
+		kind    string
+		name    string
+		str     string
+		members []string
+	}{
+		{"protocol", "Notify.Channel", "defprotocol Notify.Channel", []string{"def send(channel, msg)"}},
+		{"impl", "Notify.Channel.Notify.Slack", "defimpl Notify.Channel, for: Notify.Slack", []string{"def send(_, msg)"}},
+	}
+	if len(symbols) != len(tests) {
+		t.Fatalf("expected %d symbols, got %d", len(tests), len(symbols))
+	}
+	for i, tt := range tests {
+		sym := symbols[i].(*Module)
+		if sym.Kind() != tt.kind || sym.Name() != tt.name || sym.String() != tt.str {
+			t.Errorf("expected %s %q (%q), got %s %q (%q)", tt.kind, tt.name, tt.str, sym.Kind(), sym.Name(), sym.String())
+		}
+		if members := languagetest.ChildStrings(sym); strings.Join(members, "|") != strings.Join(tt.members, "|") {
+			t.Errorf("%s: expected members %v, got %v", tt.name, tt.members, members)
+		}
+	}
+
+	if doc := symbols[0].(*Module).Children()[0].(*Function).DocComment(); doc != "Sends a message" {
+		t.Errorf("expected doc comment 'Sends a message', got %q", doc)
+	}
+}
@@ -0,0 +1,52 @@
+package elixir
+
+import (
+	"strconv"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Module represents a defmodule, defprotocol or defimpl. Functions, macros
+// and nested modules are its children.
+type Module struct {
+	languages.Nesting
+	name   string // e.g., "Notify.Mailer"; implementations are named "<protocol>.<for>"
+	kind   string // "module", "protocol" or "impl"
+	header string // e.g., "defmodule Notify.Mailer", "defimpl String.Chars, for: User"
+	doc    string // From @moduledoc
+	loc    languages.Range
+}
+
+func (m *Module) Name() string              { return m.name }
+func (m *Module) Kind() string              { return m.kind }
+func (m *Module) Location() languages.Range { return m.loc }
+func (m *Module) String() string            { return m.header }
+func (m *Module) DocComment() string        { return m.doc }
+
+// Function represents a function or macro. Consecutive clauses with the
+// same name and arity are one Function spanning all of them.
+type Function struct {
+	languages.Nesting
+	name   string
+	kind   string // "def", "defp", "defmacro" or "defmacrop"
+	arity  int
+	header string // Head of the first clause (e.g., "def deliver(user, opts \\ [])")
+	doc    string // From @doc
+	loc    languages.Range
+}
+
+func (f *Function) Name() string              { return f.name }
+func (f *Function) Kind() string              { return f.kind }
+func (f *Function) Location() languages.Range { return f.loc }
+func (f *Function) String() string            { return f.header }
+func (f *Function) DocComment() string        { return f.doc }
+
+// Selectors returns the function's name with its arity, as Elixir refers to
+// functions (e.g., "Notify.Mailer.deliver/2", "deliver/2")
+func (f *Function) Selectors() []string {
+	nameArity := f.name + "/" + strconv.Itoa(f.arity)
+	if parent := f.Parent(); parent != nil {
+		return []string{languages.QualifiedName(parent) + "." + nameArity, nameArity}
+	}
+	return []string{nameArity}
+}
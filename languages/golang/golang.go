@@ -169,18 +169,162 @@ func extractTypes(node *sitter.Node, content []byte) []languages.Symbol {
 			typeNode := child.ChildByFieldName("type")
 			typeKind := getTypeKind(typeNode, content)
 
-			symbols = append(symbols, &Type{
+			typ := &Type{
 				name:     name,
 				typeKind: typeKind,
 				doc:      doc,
 				loc:      languages.NodeRange(child),
-			})
+			}
+
+			var members []languages.Symbol
+			switch typeKind {
+			case "struct":
+				members = extractFields(typeNode, content)
+			case "interface":
+				members = extractMethodSpecs(typeNode, content)
+			}
+			for _, member := range members {
+				languages.AddChild(typ, member)
+			}
+
+			symbols = append(symbols, typ)
 		}
 	}
 
 	return symbols
 }
 
+// extractFields extracts the fields of a struct type
+func extractFields(node *sitter.Node, content []byte) []languages.Symbol {
+	var fields []languages.Symbol
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		list := node.NamedChild(i)
+		if list.Type() != "field_declaration_list" {
+			continue
+		}
+		for j := 0; j < int(list.NamedChildCount()); j++ {
+			child := list.NamedChild(j)
+			if child.Type() != "field_declaration" {
+				continue
+			}
+
+			typeNode := child.ChildByFieldName("type")
+			typeStr := getTypeKind(typeNode, content)
+			tag := ""
+			if tagNode := child.ChildByFieldName("tag"); tagNode != nil {
+				tag = tagNode.Content(content)
+			}
+			doc := extractFieldDoc(child, content)
+
+			var names []string
+			for k := 0; k < int(child.NamedChildCount()); k++ {
+				if child.NamedChild(k).Type() == "field_identifier" {
+					names = append(names, child.NamedChild(k).Content(content))
+				}
+			}
+
+			// Embedded fields are named after their type
+			if len(names) == 0 {
+				if typeNode == nil {
+					continue
+				}
+				typeStr = child.Content(content)
+				if tag != "" {
+					typeStr = strings.TrimSpace(strings.TrimSuffix(typeStr, tag))
+				}
+				fields = append(fields, &Field{
+					name:     embeddedName(typeNode, content),
+					typeStr:  typeStr,
+					tag:      tag,
+					embedded: true,
+					doc:      doc,
+					loc:      languages.NodeRange(child),
+				})
+				continue
+			}
+
+			for _, name := range names {
+				fields = append(fields, &Field{
+					name:    name,
+					typeStr: typeStr,
+					tag:     tag,
+					doc:     doc,
+					loc:     languages.NodeRange(child),
+				})
+			}
+		}
+	}
+
+	return fields
+}
+
+// extractMethodSpecs extracts the method set of an interface type,
+// including embedded interfaces
+func extractMethodSpecs(node *sitter.Node, content []byte) []languages.Symbol {
+	var methods []languages.Symbol
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "method_elem", "method_spec":
+			nameNode := child.ChildByFieldName("name")
+			name := ""
+			if nameNode != nil {
+				name = nameNode.Content(content)
+			}
+
+			params := child.ChildByFieldName("parameters")
+			result := child.ChildByFieldName("result")
+
+			methods = append(methods, &MethodSpec{
+				name:      name,
+				signature: formatSignature(params, result, content),
+				doc:       extractFieldDoc(child, content),
+				loc:       languages.NodeRange(child),
+			})
+		case "type_elem":
+			// Only plain embedded interfaces; unions and approximations are constraints
+			if child.NamedChildCount() != 1 {
+				continue
+			}
+			typeNode := child.NamedChild(0)
+			if typeNode.Type() != "type_identifier" && typeNode.Type() != "qualified_type" {
+				continue
+			}
+			methods = append(methods, &Field{
+				name:     embeddedName(typeNode, content),
+				typeStr:  typeNode.Content(content),
+				embedded: true,
+				doc:      extractFieldDoc(child, content),
+				loc:      languages.NodeRange(child),
+			})
+		}
+	}
+
+	return methods
+}
+
+// embeddedName returns the implicit field name of an embedded type
+// (e.g., "Reader" for io.Reader, "Base" for *Base)
+func embeddedName(node *sitter.Node, content []byte) string {
+	switch node.Type() {
+	case "pointer_type":
+		if node.NamedChildCount() > 0 {
+			return embeddedName(node.NamedChild(0), content)
+		}
+	case "qualified_type":
+		if nameNode := node.ChildByFieldName("name"); nameNode != nil {
+			return nameNode.Content(content)
+		}
+	case "generic_type":
+		if typeNode := node.ChildByFieldName("type"); typeNode != nil {
+			return embeddedName(typeNode, content)
+		}
+	}
+	return node.Content(content)
+}
+
 // extractConsts extracts const declarations
 func extractConsts(node *sitter.Node, content []byte) []languages.Symbol {
 	var symbols []languages.Symbol
@@ -359,7 +503,34 @@ func extractDoc(node *sitter.Node, content []byte) string {
 		return ""
 	}
 
-	text := prev.Content(content)
+	return commentText(prev, content)
+}
+
+// extractFieldDoc extracts the doc comment of a struct field or interface method.
+// A comment on the line above is preferred, falling back to a trailing comment
+// on the same line. Trailing comments of the previous member are not docs.
+func extractFieldDoc(node *sitter.Node, content []byte) string {
+	prev := node.PrevNamedSibling()
+	if prev != nil && prev.Type() == "comment" {
+		before := prev.PrevNamedSibling()
+		if before == nil || before.EndPoint().Row != prev.StartPoint().Row {
+			if doc := extractDoc(node, content); doc != "" {
+				return doc
+			}
+		}
+	}
+
+	next := node.NextNamedSibling()
+	if next != nil && next.Type() == "comment" && next.StartPoint().Row == node.EndPoint().Row {
+		return commentText(next, content)
+	}
+
+	return ""
+}
+
+// commentText returns the first non-empty line of a comment without its markers
+func commentText(node *sitter.Node, content []byte) string {
+	text := node.Content(content)
 	text = strings.TrimPrefix(text, "//")
 	text = strings.TrimPrefix(text, "/*")
 	text = strings.TrimSuffix(text, "*/")
@@ -359,3 +359,121 @@ const (
 		}
 	}
 }
+
+func TestParseStructFields(t *testing.T) {
+	src := `package main
+
+// Config holds settings
+type Config struct {
+	// SkipPatterns are prefixes to skip
+	SkipPatterns []string ` + "`json:\"skip\"`" + `
+	Width, Height int // Dimensions
+	io.Reader
+	*Base
+	inner struct {
+		X int
+	}
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 symbol, got %d", len(symbols))
+	}
+
+	typ, ok := symbols[0].(*Type)
+	if !ok {
+		t.Fatalf("expected *Type, got %T", symbols[0])
+	}
+
+	expected := []struct {
+		name string
+		kind string
+		str  string
+		doc  string
+		line int
+	}{
+		{"SkipPatterns", "field", "SkipPatterns []string `json:\"skip\"`", "SkipPatterns are prefixes to skip", 5},
+		{"Width", "field", "Width int", "Dimensions", 6},
+		{"Height", "field", "Height int", "Dimensions", 6},
+		{"Reader", "embed", "io.Reader", "", 7},
+		{"Base", "embed", "*Base", "", 8},
+		{"inner", "field", "inner struct", "", 9},
+	}
+
+	fields := typ.Children()
+	if len(fields) != len(expected) {
+		t.Fatalf("expected %d fields, got %d", len(expected), len(fields))
+	}
+	for i, exp := range expected {
+		f := fields[i].(*Field)
+		if f.Name() != exp.name {
+			t.Errorf("field[%d]: expected name %q, got %q", i, exp.name, f.Name())
+		}
+		if f.Kind() != exp.kind {
+			t.Errorf("field[%d]: expected kind %q, got %q", i, exp.kind, f.Kind())
+		}
+		if f.String() != exp.str {
+			t.Errorf("field[%d]: expected String() %q, got %q", i, exp.str, f.String())
+		}
+		if f.DocComment() != exp.doc {
+			t.Errorf("field[%d]: expected doc %q, got %q", i, exp.doc, f.DocComment())
+		}
+		if f.Location().Start.Line != exp.line {
+			t.Errorf("field[%d]: expected line %d, got %d", i, exp.line, f.Location().Start.Line)
+		}
+	}
+}
+
+func TestParseInterfaceMethods(t *testing.T) {
+	src := `package main
+
+type Handler interface {
+	io.Closer
+	// Serve handles a request
+	Serve(ctx context.Context, r *Request) (int, error)
+	Name() string
+}
+
+type Number interface {
+	~int | ~float64
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 2 {
+		t.Fatalf("expected 2 symbols, got %d", len(symbols))
+	}
+
+	methods := symbols[0].(*Type).Children()
+	expected := []string{"io.Closer", "Serve(context.Context, *Request) (int, error)", "Name() string"}
+	if len(methods) != len(expected) {
+		t.Fatalf("expected %d members, got %d", len(expected), len(methods))
+	}
+	for i, exp := range expected {
+		if methods[i].String() != exp {
+			t.Errorf("member[%d]: expected %q, got %q", i, exp, methods[i].String())
+		}
+	}
+
+	serve := methods[1].(*MethodSpec)
+	if serve.Kind() != "method" {
+		t.Errorf("expected kind 'method', got %q", serve.Kind())
+	}
+	if serve.DocComment() != "Serve handles a request" {
+		t.Errorf("expected doc comment, got %q", serve.DocComment())
+	}
+
+	// Type set constraints have no method set
+	if n := len(symbols[1].(*Type).Children()); n != 0 {
+		t.Errorf("expected no members for constraint interface, got %d", n)
+	}
+}
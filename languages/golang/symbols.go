@@ -2,6 +2,7 @@ package golang
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/roveo/topo-mcp/languages"
 )
@@ -39,8 +40,36 @@ func (m *Method) String() string {
 }
 func (m *Method) DocComment() string { return m.doc }
 
-// Type represents a Go type declaration
+// Selectors returns the receiver-qualified names of the method
+// (e.g., "(*Server).Start" and "Server.Start")
+func (m *Method) Selectors() []string {
+	if m.receiver == "" {
+		return nil
+	}
+	return []string{
+		fmt.Sprintf("(%s).%s", m.receiver, m.name),
+		fmt.Sprintf("%s.%s", receiverType(m.receiver), m.name),
+	}
+}
+
+// Scope returns the receiver type, so that methods are addressed as
+// "Server.Start" rather than by their bare name
+func (m *Method) Scope() string { return receiverType(m.receiver) }
+
+// receiverType strips the pointer and type arguments from a receiver type
+// (e.g., "*Server[T]" -> "Server")
+func receiverType(receiver string) string {
+	receiver = strings.TrimPrefix(receiver, "*")
+	if idx := strings.Index(receiver, "["); idx != -1 {
+		receiver = receiver[:idx]
+	}
+	return strings.TrimSpace(receiver)
+}
+
+// Type represents a Go type declaration.
+// Struct fields and interface methods are its children.
 type Type struct {
+	languages.Nesting
 	name     string
 	typeKind string
 	doc      string
@@ -55,6 +84,54 @@ func (t *Type) String() string {
 }
 func (t *Type) DocComment() string { return t.doc }
 
+// Field represents a struct field or an embedded type
+type Field struct {
+	languages.Nesting
+	name     string
+	typeStr  string
+	tag      string
+	embedded bool
+	doc      string
+	loc      languages.Range
+}
+
+func (f *Field) Name() string { return f.name }
+func (f *Field) Kind() string {
+	if f.embedded {
+		return "embed"
+	}
+	return "field"
+}
+func (f *Field) Location() languages.Range { return f.loc }
+func (f *Field) String() string {
+	s := f.typeStr
+	if !f.embedded {
+		s = fmt.Sprintf("%s %s", f.name, f.typeStr)
+	}
+	if f.tag != "" {
+		s += " " + f.tag
+	}
+	return s
+}
+func (f *Field) DocComment() string { return f.doc }
+
+// MethodSpec represents a method in an interface's method set
+type MethodSpec struct {
+	languages.Nesting
+	name      string
+	signature string
+	doc       string
+	loc       languages.Range
+}
+
+func (m *MethodSpec) Name() string              { return m.name }
+func (m *MethodSpec) Kind() string              { return "method" }
+func (m *MethodSpec) Location() languages.Range { return m.loc }
+func (m *MethodSpec) String() string {
+	return fmt.Sprintf("%s%s", m.name, m.signature)
+}
+func (m *MethodSpec) DocComment() string { return m.doc }
+
 // Const represents a Go const declaration
 type Const struct {
 	name string
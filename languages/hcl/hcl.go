@@ -0,0 +1,242 @@
+package hcl
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/hcl"
+)
+
+func init() {
+	languages.Register(&Language{})
+}
+
+// Language implements the HCL language parser, with Terraform naming
+type Language struct{}
+
+func (l *Language) Name() string         { return "hcl" }
+func (l *Language) Extensions() []string { return []string{".tf", ".tfvars", ".hcl"} }
+
+func (l *Language) TreeSitterLang() *sitter.Language {
+	return hcl.GetLanguage()
+}
+
+func (l *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(hcl.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse HCL file: %w", err)
+	}
+	defer tree.Close()
+
+	body := languages.FirstChildOfType(tree.RootNode(), "body")
+	if body == nil {
+		return nil, nil, nil
+	}
+
+	var symbols []languages.Symbol
+
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		child := body.NamedChild(i)
+		switch child.Type() {
+		case "block":
+			if blockType(child, content) == "locals" {
+				symbols = append(symbols, extractLocals(child, content)...)
+				continue
+			}
+			symbols = append(symbols, extractBlock(child, content, true))
+		case "attribute":
+			symbols = append(symbols, &Attribute{
+				name: attributeName(child, content),
+				kind: "attribute",
+				doc:  extractDoc(child, content),
+				loc:  languages.NodeRange(child),
+			})
+		}
+	}
+
+	return nil, symbols, nil
+}
+
+// extractBlock extracts a block and its nested blocks. Top-level blocks are
+// named by Terraform's reference syntax.
+func extractBlock(node *sitter.Node, content []byte, topLevel bool) *Block {
+	typ := blockType(node, content)
+	labels := blockLabels(node, content)
+
+	block := &Block{
+		name:   strings.Join(append([]string{typ}, labels...), "."),
+		kind:   typ,
+		header: header(node, content),
+		doc:    extractDoc(node, content),
+		loc:    languages.NodeRange(node),
+	}
+
+	body := languages.FirstChildOfType(node, "body")
+	if topLevel {
+		switch {
+		case typ == "resource" && len(labels) == 2:
+			block.name = labels[0] + "." + labels[1]
+		case typ == "variable" && len(labels) == 1:
+			block.name = "var." + labels[0]
+		case typ == "provider" && len(labels) == 1:
+			block.name = labels[0]
+			if alias := attributeString(body, "alias", content); alias != "" {
+				block.name += "." + alias
+				block.header += fmt.Sprintf(" { alias = %q }", alias)
+			}
+		}
+		// Variables and outputs document themselves with a description
+		if block.doc == "" && (typ == "variable" || typ == "output") {
+			block.doc = attributeString(body, "description", content)
+		}
+	}
+
+	if body == nil {
+		return block
+	}
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		if child := body.NamedChild(i); child.Type() == "block" {
+			languages.AddChild(block, extractBlock(child, content, false))
+		}
+	}
+	return block
+}
+
+// extractLocals extracts each entry of a locals block as "local.<name>"
+func extractLocals(node *sitter.Node, content []byte) []languages.Symbol {
+	body := languages.FirstChildOfType(node, "body")
+	if body == nil {
+		return nil
+	}
+	var symbols []languages.Symbol
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		attr := body.NamedChild(i)
+		if attr.Type() != "attribute" {
+			continue
+		}
+		symbols = append(symbols, &Attribute{
+			name: "local." + attributeName(attr, content),
+			kind: "local",
+			doc:  extractDoc(attr, content),
+			loc:  languages.NodeRange(attr),
+		})
+	}
+	return symbols
+}
+
+// blockType returns the type of a block (e.g., "resource")
+func blockType(node *sitter.Node, content []byte) string {
+	if id := languages.FirstChildOfType(node, "identifier"); id != nil {
+		return id.Content(content)
+	}
+	return ""
+}
+
+// blockLabels returns the unquoted labels of a block (e.g., ["aws_s3_bucket", "logs"])
+func blockLabels(node *sitter.Node, content []byte) []string {
+	var labels []string
+	for i := 1; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "string_lit":
+			labels = append(labels, unquote(child.Content(content)))
+		case "identifier":
+			labels = append(labels, child.Content(content))
+		}
+	}
+	return labels
+}
+
+// header returns the block's type and labels as written, on one line
+func header(node *sitter.Node, content []byte) string {
+	end := node.EndByte()
+	if start := languages.FirstChildOfType(node, "block_start"); start != nil {
+		end = start.StartByte()
+	}
+	return strings.Join(strings.Fields(string(content[node.StartByte():end])), " ")
+}
+
+// attributeString returns the value of a string attribute in a block body,
+// or "" if the attribute is missing or not a plain string
+func attributeString(body *sitter.Node, name string, content []byte) string {
+	if body == nil {
+		return ""
+	}
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		attr := body.NamedChild(i)
+		if attr.Type() != "attribute" || attributeName(attr, content) != name {
+			continue
+		}
+		expr := languages.FirstChildOfType(attr, "expression")
+		if expr == nil {
+			return ""
+		}
+		if lit := languages.FirstChildOfType(expr, "literal_value"); lit != nil {
+			if str := languages.FirstChildOfType(lit, "string_lit"); str != nil {
+				return unquote(str.Content(content))
+			}
+		}
+		return ""
+	}
+	return ""
+}
+
+// unquote strips the quotes from a string literal and resolves its escapes
+func unquote(s string) string {
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		return unquoted
+	}
+	return strings.Trim(s, `"`)
+}
+
+// attributeName returns the name of an attribute
+func attributeName(attr *sitter.Node, content []byte) string {
+	if id := languages.FirstChildOfType(attr, "identifier"); id != nil {
+		return id.Content(content)
+	}
+	return ""
+}
+
+// extractDoc extracts the first line of the comments (# or // lines, or a
+// /* */ block) directly preceding a block or attribute
+func extractDoc(node *sitter.Node, content []byte) string {
+	// Comments before the first entry of a block precede the block's body
+	first := node.PrevNamedSibling()
+	if parent := node.Parent(); first == nil && parent != nil && parent.Type() == "body" {
+		first = parent.PrevNamedSibling()
+	}
+
+	var lines []string
+	expectedRow := node.StartPoint().Row
+	for prev := first; prev != nil && prev.Type() == "comment"; prev = prev.PrevNamedSibling() {
+		if prev.EndPoint().Row+1 != expectedRow {
+			break
+		}
+		text := prev.Content(content)
+		switch {
+		case strings.HasPrefix(text, "/*"):
+			text = strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+		case strings.HasPrefix(text, "//"):
+			text = strings.TrimPrefix(text, "//")
+		default:
+			text = strings.TrimPrefix(text, "#")
+		}
+		lines = append(strings.Split(text, "\n"), lines...)
+		expectedRow = prev.StartPoint().Row
+	}
+
+	for _, line := range lines {
+		if line = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*")); line != "" {
+			return line
+		}
+	}
+	return ""
+}
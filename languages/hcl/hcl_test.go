@@ -0,0 +1,192 @@
+package hcl
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+func TestLanguageMetadata(t *testing.T) {
+	lang := &Language{}
+
+	if lang.Name() != "hcl" {
+		t.Errorf("expected name 'hcl', got %q", lang.Name())
+	}
+
+	exts := lang.Extensions()
+	if strings.Join(exts, ",") != ".tf,.tfvars,.hcl" {
+		t.Errorf("expected extensions [.tf .tfvars .hcl], got %v", exts)
+	}
+}
+
+func TestParseTerraform(t *testing.T) {
+	src := `terraform {
+  required_version = ">= 1.5"
+}
+
+provider "aws" {
+  region = var.region
+}
+
+provider "aws" {
+  alias  = "west"
+  region = "us-west-2"
+}
+
+# Region to deploy into
+variable "region" {
+  type    = string
+  default = "us-east-1"
+}
+
+variable "env" {
+  description = "Deployment environment"
+}
+
+locals {
+  # Prefix for all resource names
+  name = "app-${var.env}"
+  tags = { Name = local.name }
+}
+
+// Access logs
+resource "aws_s3_bucket" "logs" {
+  bucket   = local.name
+  provider = aws.west
+
+  lifecycle {
+    prevent_destroy = true
+  }
+}
+
+data "aws_ami" "ubuntu" {
+  most_recent = true
+}
+
+module "vpc" {
+  source = "./modules/vpc"
+}
+
+output "vpc_id" {
+  value = module.vpc.id
+}
+`
+	lang := &Language{}
+	imports, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(imports) != 0 {
+		t.Errorf("expected no imports, got %v", imports)
+	}
+
+	tests := []struct {
+		kind string
+		name string
+		str  string
+		doc  string
+	}{
+		{"terraform", "terraform", "terraform", ""},
+		{"provider", "aws", `provider "aws"`, ""},
+		{"provider", "aws.west", `provider "aws" { alias = "west" }`, ""},
+		{"variable", "var.region", `variable "region"`, "Region to deploy into"},
+		{"variable", "var.env", `variable "env"`, "Deployment environment"},
+		{"local", "local.name", "local.name", "Prefix for all resource names"},
+		{"local", "local.tags", "local.tags", ""},
+		{"resource", "aws_s3_bucket.logs", `resource "aws_s3_bucket" "logs"`, "Access logs"},
+		{"data", "data.aws_ami.ubuntu", `data "aws_ami" "ubuntu"`, ""},
+		{"module", "module.vpc", `module "vpc"`, ""},
+		{"output", "output.vpc_id", `output "vpc_id"`, ""},
+	}
+
+	if len(symbols) != len(tests) {
+		t.Fatalf("expected %d symbols, got %d", len(tests), len(symbols))
+	}
+	for i, tt := range tests {
+		sym := symbols[i]
+		if sym.Kind() != tt.kind || sym.Name() != tt.name {
+			t.Errorf("expected %s %q, got %s %q", tt.kind, tt.name, sym.Kind(), sym.Name())
+		}
+		if sym.String() != tt.str {
+			t.Errorf("unexpected String(): got %q, want %q", sym.String(), tt.str)
+		}
+		if doc := sym.(languages.Documented).DocComment(); doc != tt.doc {
+			t.Errorf("%s: expected doc comment %q, got %q", sym.Name(), tt.doc, doc)
+		}
+	}
+
+	bucket := symbols[7]
+	if loc := bucket.Location(); loc.Start.Line != 30 || loc.End.Line != 37 {
+		t.Errorf("expected bucket on lines 30-37, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+
+	// Nested blocks are children of their block
+	if found := languages.Lookup(symbols, "aws_s3_bucket.logs.lifecycle"); len(found) != 1 {
+		t.Errorf("expected to find aws_s3_bucket.logs.lifecycle, got %d matches", len(found))
+	}
+}
+
+func TestParseGenericHCL(t *testing.T) {
+	src := `# Packer template
+source "amazon-ebs" "ubuntu" {
+  ami_name = "app"
+}
+
+build {
+  sources = ["source.amazon-ebs.ubuntu"]
+
+  provisioner "shell" {
+    inline = ["echo hi"]
+  }
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 2 {
+		t.Fatalf("expected 2 symbols, got %d", len(symbols))
+	}
+	if symbols[0].Name() != "source.amazon-ebs.ubuntu" || symbols[0].Kind() != "source" {
+		t.Errorf("expected source source.amazon-ebs.ubuntu, got %s %q", symbols[0].Kind(), symbols[0].Name())
+	}
+
+	children := languages.ChildrenOf(symbols[1])
+	if len(children) != 1 || children[0].Name() != "provisioner.shell" {
+		t.Errorf("expected build to contain provisioner.shell, got %v", children)
+	}
+}
+
+func TestParseTfvars(t *testing.T) {
+	src := `region = "us-west-2"
+
+# Subnets for the VPC
+cidrs = [
+  "10.0.0.0/16",
+]
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 2 {
+		t.Fatalf("expected 2 symbols, got %d", len(symbols))
+	}
+	for i, name := range []string{"region", "cidrs"} {
+		if symbols[i].Name() != name || symbols[i].Kind() != "attribute" {
+			t.Errorf("expected attribute %q, got %s %q", name, symbols[i].Kind(), symbols[i].Name())
+		}
+	}
+	cidrs := symbols[1].(*Attribute)
+	if cidrs.DocComment() != "Subnets for the VPC" {
+		t.Errorf("expected doc comment 'Subnets for the VPC', got %q", cidrs.DocComment())
+	}
+	if loc := cidrs.Location(); loc.Start.Line != 3 || loc.End.Line != 5 {
+		t.Errorf("expected cidrs on lines 3-5, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+}
@@ -0,0 +1,43 @@
+package hcl
+
+import (
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Block represents a block (e.g., a Terraform resource). Blocks nested in
+// its body are its children.
+//
+// Terraform blocks are named the way they are referenced: "aws_s3_bucket.logs",
+// "data.aws_ami.ubuntu", "module.vpc", "var.region", "output.vpc_id", and
+// "aws" or "aws.west" for providers. Other blocks are named by their type
+// and labels (e.g., "terraform", "source.amazon-ebs.ubuntu").
+type Block struct {
+	languages.Nesting
+	name   string
+	kind   string // Block type (e.g., "resource", "variable", "lifecycle")
+	header string // e.g., `resource "aws_s3_bucket" "logs"`
+	doc    string
+	loc    languages.Range
+}
+
+func (b *Block) Name() string              { return b.name }
+func (b *Block) Kind() string              { return b.kind }
+func (b *Block) Location() languages.Range { return b.loc }
+func (b *Block) String() string            { return b.header }
+func (b *Block) DocComment() string        { return b.doc }
+
+// Attribute represents a local value ("local.name") or a top-level attribute,
+// as in .tfvars files
+type Attribute struct {
+	languages.Nesting
+	name string
+	kind string // "local" or "attribute"
+	doc  string
+	loc  languages.Range
+}
+
+func (a *Attribute) Name() string              { return a.name }
+func (a *Attribute) Kind() string              { return a.kind }
+func (a *Attribute) Location() languages.Range { return a.loc }
+func (a *Attribute) String() string            { return a.name }
+func (a *Attribute) DocComment() string        { return a.doc }
@@ -0,0 +1,312 @@
+package java
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/java"
+)
+
+func init() {
+	languages.Register(&Language{})
+}
+
+// Language implements the Java language parser
+type Language struct{}
+
+func (j *Language) Name() string         { return "java" }
+func (j *Language) Extensions() []string { return []string{".java"} }
+
+func (j *Language) TreeSitterLang() *sitter.Language {
+	return java.GetLanguage()
+}
+
+func (j *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(java.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Java file: %w", err)
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	var imports []string
+	var symbols []languages.Symbol
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		child := root.NamedChild(i)
+		switch child.Type() {
+		case "package_declaration":
+			if pkg := extractPackage(child, content); pkg != nil {
+				symbols = append(symbols, pkg)
+			}
+		case "import_declaration":
+			if imp := extractImport(child, content); imp != "" {
+				imports = append(imports, imp)
+			}
+		case "class_declaration", "interface_declaration", "enum_declaration",
+			"record_declaration", "annotation_type_declaration":
+			symbols = append(symbols, extractType(child, content))
+		}
+	}
+
+	return imports, symbols, nil
+}
+
+// extractPackage extracts the package declaration
+func extractPackage(node *sitter.Node, content []byte) languages.Symbol {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() == "scoped_identifier" || child.Type() == "identifier" {
+			return &Package{
+				name: child.Content(content),
+				loc:  languages.NodeRange(node),
+			}
+		}
+	}
+	return nil
+}
+
+// extractImport extracts the imported name, including a trailing ".*" for
+// on-demand imports. Static imports are recorded like regular ones.
+func extractImport(node *sitter.Node, content []byte) string {
+	var sb strings.Builder
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "scoped_identifier", "identifier":
+			sb.WriteString(child.Content(content))
+		case "asterisk":
+			sb.WriteString(".*")
+		}
+	}
+	return sb.String()
+}
+
+// extractType extracts a class, interface, enum, record or annotation type
+// declaration together with its members
+func extractType(node *sitter.Node, content []byte) languages.Symbol {
+	typ := &Type{
+		name:      languages.FieldContent(node, "name", content),
+		modifiers: extractModifiers(node, content),
+		doc:       extractDoc(node, content),
+		loc:       languages.NodeRange(node),
+	}
+
+	switch node.Type() {
+	case "class_declaration":
+		typ.keyword = "class"
+	case "interface_declaration":
+		typ.keyword = "interface"
+	case "enum_declaration":
+		typ.keyword = "enum"
+	case "record_declaration":
+		typ.keyword = "record"
+		typ.components = languages.Compact(languages.FieldContent(node, "parameters", content))
+	case "annotation_type_declaration":
+		typ.keyword = "@interface"
+	}
+
+	if params := node.ChildByFieldName("type_parameters"); params != nil {
+		typ.typeParams = languages.Compact(params.Content(content))
+	}
+	if super := node.ChildByFieldName("superclass"); super != nil && super.NamedChildCount() > 0 {
+		typ.extends = []string{super.NamedChild(0).Content(content)}
+	}
+	if ifaces := node.ChildByFieldName("interfaces"); ifaces != nil {
+		typ.implements = typeList(ifaces, content)
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		// Interfaces list their super-interfaces in an extends_interfaces node
+		if child := node.NamedChild(i); child.Type() == "extends_interfaces" {
+			typ.extends = typeList(child, content)
+		}
+	}
+
+	if body := node.ChildByFieldName("body"); body != nil {
+		for _, member := range extractMembers(body, content) {
+			languages.AddChild(typ, member)
+		}
+	}
+
+	return typ
+}
+
+// extractMembers extracts the members of a type body
+func extractMembers(body *sitter.Node, content []byte) []languages.Symbol {
+	var members []languages.Symbol
+
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		child := body.NamedChild(i)
+		switch child.Type() {
+		case "class_declaration", "interface_declaration", "enum_declaration",
+			"record_declaration", "annotation_type_declaration":
+			members = append(members, extractType(child, content))
+		case "method_declaration", "annotation_type_element_declaration":
+			members = append(members, extractMethod(child, content))
+		case "constructor_declaration", "compact_constructor_declaration":
+			method := extractMethod(child, content)
+			method.constructor = true
+			members = append(members, method)
+		case "field_declaration", "constant_declaration":
+			members = append(members, extractFields(child, content)...)
+		case "enum_constant":
+			members = append(members, &Field{
+				name: languages.FieldContent(child, "name", content),
+				doc:  extractDoc(child, content),
+				loc:  languages.NodeRange(child),
+			})
+		case "enum_body_declarations":
+			// Members following the constants of an enum
+			members = append(members, extractMembers(child, content)...)
+		}
+	}
+
+	return members
+}
+
+// extractMethod extracts a method, constructor or annotation type element
+func extractMethod(node *sitter.Node, content []byte) *Method {
+	method := &Method{
+		name:      languages.FieldContent(node, "name", content),
+		modifiers: extractModifiers(node, content),
+		doc:       extractDoc(node, content),
+		loc:       languages.NodeRange(node),
+	}
+
+	if params := node.ChildByFieldName("type_parameters"); params != nil {
+		method.typeParams = languages.Compact(params.Content(content))
+	}
+	if typeNode := node.ChildByFieldName("type"); typeNode != nil {
+		method.returnType = languages.Compact(typeNode.Content(content))
+	}
+
+	switch node.Type() {
+	case "annotation_type_element_declaration":
+		method.params = "()"
+	case "compact_constructor_declaration":
+		// Compact constructors take the record components implicitly
+	default:
+		method.params = languages.Compact(languages.FieldContent(node, "parameters", content))
+	}
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == "throws" {
+			method.throws = languages.Compact(child.Content(content))
+		}
+	}
+
+	return method
+}
+
+// extractFields extracts every variable declared by a field or constant declaration
+func extractFields(node *sitter.Node, content []byte) []languages.Symbol {
+	var fields []languages.Symbol
+
+	modifiers := extractModifiers(node, content)
+	typeStr := languages.Compact(languages.FieldContent(node, "type", content))
+	doc := extractDoc(node, content)
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() != "variable_declarator" {
+			continue
+		}
+		// Array dimensions may follow the name (int a[])
+		fieldType := typeStr
+		if dims := child.ChildByFieldName("dimensions"); dims != nil {
+			fieldType += dims.Content(content)
+		}
+		fields = append(fields, &Field{
+			name:      languages.FieldContent(child, "name", content),
+			modifiers: modifiers,
+			typeStr:   fieldType,
+			doc:       doc,
+			loc:       languages.NodeRange(node),
+		})
+	}
+
+	return fields
+}
+
+// extractModifiers returns the annotations (without arguments) and modifier
+// keywords of a declaration, in source order
+func extractModifiers(node *sitter.Node, content []byte) []string {
+	var modifiers []string
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() != "modifiers" {
+			continue
+		}
+		for j := 0; j < int(child.ChildCount()); j++ {
+			mod := child.Child(j)
+			switch mod.Type() {
+			case "marker_annotation", "annotation":
+				modifiers = append(modifiers, "@"+languages.FieldContent(mod, "name", content))
+			default:
+				modifiers = append(modifiers, mod.Content(content))
+			}
+		}
+	}
+
+	return modifiers
+}
+
+// typeList returns the types of a super_interfaces or extends_interfaces node
+func typeList(node *sitter.Node, content []byte) []string {
+	var types []string
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() != "type_list" {
+			continue
+		}
+		for j := 0; j < int(child.NamedChildCount()); j++ {
+			types = append(types, languages.Compact(child.NamedChild(j).Content(content)))
+		}
+	}
+	return types
+}
+
+// extractDoc extracts the first line of the Javadoc comment preceding a declaration
+func extractDoc(node *sitter.Node, content []byte) string {
+	prev := node.PrevNamedSibling()
+	if prev == nil || prev.Type() != "block_comment" {
+		return ""
+	}
+
+	commentEndLine := prev.EndPoint().Row
+	declStartLine := node.StartPoint().Row
+	if declStartLine-commentEndLine > 1 {
+		return ""
+	}
+
+	text := prev.Content(content)
+	if !strings.HasPrefix(text, "/**") {
+		return ""
+	}
+	text = strings.TrimPrefix(text, "/**")
+	text = strings.TrimSuffix(text, "*/")
+
+	for line := range strings.SplitSeq(text, "\n") {
+		line = strings.TrimSpace(line)
+		line = strings.TrimPrefix(line, "*")
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "@") {
+			// Block tags (@param, @return) start after the description
+			return ""
+		}
+		if line != "" {
+			return line
+		}
+	}
+
+	return ""
+}
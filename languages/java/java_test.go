@@ -0,0 +1,336 @@
+package java
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+	"github.com/roveo/topo-mcp/languages/languagetest"
+)
+
+func TestLanguageMetadata(t *testing.T) {
+	lang := &Language{}
+
+	if lang.Name() != "java" {
+		t.Errorf("expected name 'java', got %q", lang.Name())
+	}
+
+	exts := lang.Extensions()
+	if len(exts) != 1 || exts[0] != ".java" {
+		t.Errorf("expected extensions [.java], got %v", exts)
+	}
+}
+
+func TestParsePackageAndImports(t *testing.T) {
+	src := `package com.example.app;
+
+import java.util.List;
+import java.util.concurrent.*;
+import static java.util.Objects.requireNonNull;
+
+class App {}
+`
+	lang := &Language{}
+	imports, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	expected := []string{"java.util.List", "java.util.concurrent.*", "java.util.Objects.requireNonNull"}
+	if strings.Join(imports, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected imports %v, got %v", expected, imports)
+	}
+
+	if len(symbols) != 2 {
+		t.Fatalf("expected 2 symbols, got %d", len(symbols))
+	}
+	pkg := symbols[0]
+	if pkg.Kind() != "package" || pkg.Name() != "com.example.app" {
+		t.Errorf("expected package com.example.app, got %s %q", pkg.Kind(), pkg.Name())
+	}
+	if pkg.String() != "package com.example.app" {
+		t.Errorf("unexpected String(): %q", pkg.String())
+	}
+}
+
+func TestParseClass(t *testing.T) {
+	src := `/**
+ * Handles incoming requests.
+ *
+ * @author someone
+ */
+@Service
+public final class Server<T extends Handler> extends Base implements Runnable, Closeable {
+    private static final int DEFAULT_PORT = 8080;
+    private int port, backlog;
+    protected String[] hosts;
+
+    /** Create a server on the default port */
+    public Server() {
+        this(DEFAULT_PORT);
+    }
+
+    public Server(int port) {
+        this.port = port;
+    }
+
+    /**
+     * @param req the request
+     */
+    @Override
+    public synchronized <R> R handle(Request req,
+                                     Class<R> type) throws IOException, TimeoutException {
+        return null;
+    }
+
+    abstract void run();
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 symbol, got %d", len(symbols))
+	}
+
+	cls, ok := symbols[0].(*Type)
+	if !ok {
+		t.Fatalf("expected *Type, got %T", symbols[0])
+	}
+	if cls.Kind() != "class" {
+		t.Errorf("expected kind 'class', got %q", cls.Kind())
+	}
+	want := "@Service public final class Server<T extends Handler> extends Base implements Runnable, Closeable"
+	if cls.String() != want {
+		t.Errorf("unexpected String():\ngot:  %q\nwant: %q", cls.String(), want)
+	}
+	if cls.DocComment() != "Handles incoming requests." {
+		t.Errorf("expected doc comment 'Handles incoming requests.', got %q", cls.DocComment())
+	}
+
+	expected := []string{
+		"private static final int DEFAULT_PORT",
+		"private int port",
+		"private int backlog",
+		"protected String[] hosts",
+		"public Server()",
+		"public Server(int port)",
+		"@Override public synchronized <R> R handle(Request req, Class<R> type) throws IOException, TimeoutException",
+		"abstract void run()",
+	}
+	got := languagetest.ChildStrings(cls)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected members:\ngot:  %q\nwant: %q", got, expected)
+	}
+
+	kinds := []string{"field", "field", "field", "field", "constructor", "constructor", "method", "method"}
+	for i, m := range cls.Children() {
+		if m.Kind() != kinds[i] {
+			t.Errorf("member %q: expected kind %q, got %q", m.Name(), kinds[i], m.Kind())
+		}
+	}
+
+	ctor := cls.Children()[4].(*Method)
+	if ctor.DocComment() != "Create a server on the default port" {
+		t.Errorf("expected constructor doc comment, got %q", ctor.DocComment())
+	}
+	handle := cls.Children()[6].(*Method)
+	if handle.DocComment() != "" {
+		t.Errorf("expected no doc comment for Javadoc with only tags, got %q", handle.DocComment())
+	}
+	if loc := handle.Location(); loc.Start.Line != 23 {
+		t.Errorf("expected handle() to start at its annotation on line 23, got %d", loc.Start.Line)
+	}
+}
+
+func TestParseInterface(t *testing.T) {
+	src := `public interface Repository<T, ID> extends Closeable, AutoCloseable {
+    int MAX = 10;
+
+    Optional<T> find(ID id);
+
+    default void close() {}
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	iface := symbols[0].(*Type)
+	if iface.Kind() != "interface" {
+		t.Errorf("expected kind 'interface', got %q", iface.Kind())
+	}
+	want := "public interface Repository<T, ID> extends Closeable, AutoCloseable"
+	if iface.String() != want {
+		t.Errorf("unexpected String():\ngot:  %q\nwant: %q", iface.String(), want)
+	}
+
+	expected := []string{
+		"int MAX",
+		"Optional<T> find(ID id)",
+		"default void close()",
+	}
+	got := languagetest.ChildStrings(iface)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected members:\ngot:  %q\nwant: %q", got, expected)
+	}
+}
+
+func TestParseEnum(t *testing.T) {
+	src := `enum Color implements Named {
+    /** Pure red */
+    RED("r"),
+    GREEN("g");
+
+    private final String code;
+
+    Color(String code) {
+        this.code = code;
+    }
+
+    public String getName() { return code; }
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	enum := symbols[0].(*Type)
+	if enum.Kind() != "enum" {
+		t.Errorf("expected kind 'enum', got %q", enum.Kind())
+	}
+	if enum.String() != "enum Color implements Named" {
+		t.Errorf("unexpected String(): %q", enum.String())
+	}
+
+	expected := []string{
+		"RED",
+		"GREEN",
+		"private final String code",
+		"Color(String code)",
+		"public String getName()",
+	}
+	got := languagetest.ChildStrings(enum)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected members:\ngot:  %q\nwant: %q", got, expected)
+	}
+
+	red := enum.Children()[0].(*Field)
+	if red.Kind() != "constant" {
+		t.Errorf("expected kind 'constant', got %q", red.Kind())
+	}
+	if red.DocComment() != "Pure red" {
+		t.Errorf("expected doc comment 'Pure red', got %q", red.DocComment())
+	}
+}
+
+func TestParseRecord(t *testing.T) {
+	src := `public record Point(int x, int y) implements Comparable<Point> {
+    public Point {
+        if (x < 0) throw new IllegalArgumentException();
+    }
+
+    static Point origin() { return new Point(0, 0); }
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	rec := symbols[0].(*Type)
+	if rec.Kind() != "record" {
+		t.Errorf("expected kind 'record', got %q", rec.Kind())
+	}
+	want := "public record Point(int x, int y) implements Comparable<Point>"
+	if rec.String() != want {
+		t.Errorf("unexpected String():\ngot:  %q\nwant: %q", rec.String(), want)
+	}
+
+	expected := []string{
+		"public Point",
+		"static Point origin()",
+	}
+	got := languagetest.ChildStrings(rec)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected members:\ngot:  %q\nwant: %q", got, expected)
+	}
+	if rec.Children()[0].Kind() != "constructor" {
+		t.Errorf("expected compact constructor kind 'constructor', got %q", rec.Children()[0].Kind())
+	}
+}
+
+func TestParseAnnotationType(t *testing.T) {
+	src := `@Retention(RetentionPolicy.RUNTIME)
+public @interface Route {
+    String value();
+    String method() default "GET";
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	ann := symbols[0].(*Type)
+	if ann.Kind() != "annotation" {
+		t.Errorf("expected kind 'annotation', got %q", ann.Kind())
+	}
+	if ann.String() != "@Retention public @interface Route" {
+		t.Errorf("unexpected String(): %q", ann.String())
+	}
+
+	expected := []string{
+		"String value()",
+		"String method()",
+	}
+	got := languagetest.ChildStrings(ann)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected members:\ngot:  %q\nwant: %q", got, expected)
+	}
+}
+
+func TestParseNestedTypes(t *testing.T) {
+	src := `class Outer {
+    static class Inner {
+        void run() {}
+    }
+
+    interface Callback {
+        void done();
+    }
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	outer := symbols[0].(*Type)
+	if len(outer.Children()) != 2 {
+		t.Fatalf("expected 2 nested types, got %d", len(outer.Children()))
+	}
+
+	inner := outer.Children()[0].(*Type)
+	if inner.String() != "static class Inner" {
+		t.Errorf("unexpected String(): %q", inner.String())
+	}
+	run := inner.Children()[0]
+	if got := languages.QualifiedName(run); got != "Outer.Inner.run" {
+		t.Errorf("expected qualified name 'Outer.Inner.run', got %q", got)
+	}
+
+	if found := languages.Lookup(symbols, "Outer.Callback.done"); len(found) != 1 {
+		t.Fatalf("expected to find Outer.Callback.done, got %d matches", len(found))
+	}
+}
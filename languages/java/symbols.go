@@ -0,0 +1,142 @@
+package java
+
+import (
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Package represents a Java package declaration
+type Package struct {
+	name string
+	loc  languages.Range
+}
+
+func (p *Package) Name() string              { return p.name }
+func (p *Package) Kind() string              { return "package" }
+func (p *Package) Location() languages.Range { return p.loc }
+func (p *Package) String() string            { return "package " + p.name }
+
+// Type represents a class, interface, enum, record or annotation type.
+// Members and nested types are its children.
+type Type struct {
+	languages.Nesting
+	name       string
+	keyword    string // "class", "interface", "enum", "record" or "@interface"
+	modifiers  []string
+	typeParams string   // e.g., "<T>"
+	components string   // Record header (e.g., "(int x, int y)")
+	extends    []string // Superclass, or extended interfaces
+	implements []string
+	doc        string
+	loc        languages.Range
+}
+
+func (t *Type) Name() string { return t.name }
+func (t *Type) Kind() string {
+	if t.keyword == "@interface" {
+		return "annotation"
+	}
+	return t.keyword
+}
+func (t *Type) Location() languages.Range { return t.loc }
+func (t *Type) String() string {
+	var sb strings.Builder
+	writeModifiers(&sb, t.modifiers)
+	sb.WriteString(t.keyword)
+	sb.WriteString(" ")
+	sb.WriteString(t.name)
+	sb.WriteString(t.typeParams)
+	sb.WriteString(t.components)
+	if len(t.extends) > 0 {
+		sb.WriteString(" extends ")
+		sb.WriteString(strings.Join(t.extends, ", "))
+	}
+	if len(t.implements) > 0 {
+		sb.WriteString(" implements ")
+		sb.WriteString(strings.Join(t.implements, ", "))
+	}
+	return sb.String()
+}
+func (t *Type) DocComment() string { return t.doc }
+
+// Method represents a method, constructor or annotation type element
+type Method struct {
+	languages.Nesting
+	name        string
+	modifiers   []string
+	typeParams  string // e.g., "<R>"
+	returnType  string // Empty for constructors
+	params      string // e.g., "(String name, int n)"
+	throws      string // e.g., "throws IOException"
+	constructor bool
+	doc         string
+	loc         languages.Range
+}
+
+func (m *Method) Name() string { return m.name }
+func (m *Method) Kind() string {
+	if m.constructor {
+		return "constructor"
+	}
+	return "method"
+}
+func (m *Method) Location() languages.Range { return m.loc }
+func (m *Method) String() string {
+	var sb strings.Builder
+	writeModifiers(&sb, m.modifiers)
+	if m.typeParams != "" {
+		sb.WriteString(m.typeParams)
+		sb.WriteString(" ")
+	}
+	if m.returnType != "" {
+		sb.WriteString(m.returnType)
+		sb.WriteString(" ")
+	}
+	sb.WriteString(m.name)
+	sb.WriteString(m.params)
+	if m.throws != "" {
+		sb.WriteString(" ")
+		sb.WriteString(m.throws)
+	}
+	return sb.String()
+}
+func (m *Method) DocComment() string { return m.doc }
+
+// Field represents a field, interface constant or enum constant
+type Field struct {
+	languages.Nesting
+	name      string
+	modifiers []string
+	typeStr   string // Empty for enum constants
+	doc       string
+	loc       languages.Range
+}
+
+func (f *Field) Name() string { return f.name }
+func (f *Field) Kind() string {
+	if f.typeStr == "" {
+		return "constant"
+	}
+	return "field"
+}
+func (f *Field) Location() languages.Range { return f.loc }
+func (f *Field) String() string {
+	var sb strings.Builder
+	writeModifiers(&sb, f.modifiers)
+	if f.typeStr != "" {
+		sb.WriteString(f.typeStr)
+		sb.WriteString(" ")
+	}
+	sb.WriteString(f.name)
+	return sb.String()
+}
+func (f *Field) DocComment() string { return f.doc }
+
+// writeModifiers writes annotations and modifier keywords followed by a space
+func writeModifiers(sb *strings.Builder, modifiers []string) {
+	for _, mod := range modifiers {
+		sb.WriteString(mod)
+		sb.WriteString(" ")
+	}
+}
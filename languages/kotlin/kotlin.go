@@ -0,0 +1,300 @@
+package kotlin
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/kotlin"
+)
+
+func init() {
+	languages.Register(&Language{})
+}
+
+// Language implements the Kotlin language parser
+type Language struct{}
+
+func (l *Language) Name() string           { return "kotlin" }
+func (l *Language) Extensions() []string   { return []string{".kt", ".kts"} }
+func (l *Language) Interpreters() []string { return []string{"kotlin"} }
+
+func (l *Language) TreeSitterLang() *sitter.Language {
+	return kotlin.GetLanguage()
+}
+
+func (l *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(kotlin.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Kotlin file: %w", err)
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	var imports []string
+	var symbols []languages.Symbol
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		child := root.NamedChild(i)
+		switch child.Type() {
+		case "package_header":
+			if id := languages.FirstChildOfType(child, "identifier"); id != nil {
+				symbols = append(symbols, &Package{
+					name: id.Content(content),
+					loc:  languages.NodeRange(child),
+				})
+			}
+		case "import_list":
+			for j := 0; j < int(child.NamedChildCount()); j++ {
+				if imp := extractImport(child.NamedChild(j), content); imp != "" {
+					imports = append(imports, imp)
+				}
+			}
+		case "import_header":
+			if imp := extractImport(child, content); imp != "" {
+				imports = append(imports, imp)
+			}
+		default:
+			symbols = append(symbols, extractDeclaration(child, content)...)
+		}
+	}
+
+	return imports, symbols, nil
+}
+
+// extractImport extracts the imported name, including a trailing ".*" for
+// wildcard imports. Aliased imports are recorded by their target.
+func extractImport(node *sitter.Node, content []byte) string {
+	if node.Type() != "import_header" {
+		return ""
+	}
+	id := languages.FirstChildOfType(node, "identifier")
+	if id == nil {
+		return ""
+	}
+	if languages.FirstChildOfType(node, "wildcard_import") != nil {
+		return id.Content(content) + ".*"
+	}
+	return id.Content(content)
+}
+
+// extractDeclaration extracts the symbols of a top-level or member declaration
+func extractDeclaration(node *sitter.Node, content []byte) []languages.Symbol {
+	switch node.Type() {
+	case "class_declaration", "object_declaration", "companion_object":
+		return []languages.Symbol{extractClass(node, content)}
+	case "function_declaration":
+		name := languages.FirstChildOfType(node, "simple_identifier")
+		if name == nil {
+			return nil
+		}
+		return []languages.Symbol{&Function{
+			name:      name.Content(content),
+			receiver:  receiverType(node, content),
+			modifiers: extractModifiers(node, content),
+			signature: signature(node, content, "function_body"),
+			doc:       extractDoc(node, content),
+			loc:       languages.NodeRange(node),
+		}}
+	case "property_declaration":
+		decl := languages.FirstChildOfType(node, "variable_declaration")
+		if decl == nil {
+			// Destructuring declarations don't declare a single property
+			return nil
+		}
+		name := languages.FirstChildOfType(decl, "simple_identifier")
+		if name == nil {
+			return nil
+		}
+		return []languages.Symbol{&Property{
+			name:      name.Content(content),
+			receiver:  receiverType(node, content),
+			modifiers: extractModifiers(node, content),
+			signature: signature(node, content, "=", "getter", "setter", "property_delegate"),
+			doc:       extractDoc(node, content),
+			loc:       languages.NodeRange(node),
+		}}
+	case "type_alias":
+		name := languages.FirstChildOfType(node, "type_identifier")
+		if name == nil {
+			return nil
+		}
+		return []languages.Symbol{&TypeAlias{
+			name:      name.Content(content),
+			modifiers: extractModifiers(node, content),
+			signature: signature(node, content),
+			doc:       extractDoc(node, content),
+			loc:       languages.NodeRange(node),
+		}}
+	}
+	return nil
+}
+
+// extractClass extracts a class, interface, object or companion object with
+// its members. Primary constructor parameters declared with val or var are
+// extracted as properties.
+func extractClass(node *sitter.Node, content []byte) *Class {
+	cls := &Class{
+		modifiers: extractModifiers(node, content),
+		signature: signature(node, content, "class_body", "enum_class_body"),
+		doc:       extractDoc(node, content),
+		loc:       languages.NodeRange(node),
+	}
+	if name := languages.FirstChildOfType(node, "type_identifier"); name != nil {
+		cls.name = name.Content(content)
+	}
+
+	switch node.Type() {
+	case "object_declaration":
+		cls.kind = "object"
+	case "companion_object":
+		cls.kind = "companion"
+		if cls.name == "" {
+			cls.name = "Companion"
+		}
+	default:
+		cls.kind = "class"
+		for i := 0; i < int(node.ChildCount()); i++ {
+			switch node.Child(i).Type() {
+			case "interface":
+				cls.kind = "interface"
+			case "enum":
+				cls.kind = "enum"
+			}
+		}
+	}
+
+	if ctor := languages.FirstChildOfType(node, "primary_constructor"); ctor != nil {
+		for i := 0; i < int(ctor.NamedChildCount()); i++ {
+			param := ctor.NamedChild(i)
+			if param.Type() != "class_parameter" || languages.FirstChildOfType(param, "binding_pattern_kind") == nil {
+				continue
+			}
+			name := languages.FirstChildOfType(param, "simple_identifier")
+			if name == nil {
+				continue
+			}
+			languages.AddChild(cls, &Property{
+				name:      name.Content(content),
+				modifiers: extractModifiers(param, content),
+				signature: signature(param, content, "="),
+				loc:       languages.NodeRange(param),
+			})
+		}
+	}
+
+	body := languages.FirstChildOfType(node, "class_body")
+	if body == nil {
+		body = languages.FirstChildOfType(node, "enum_class_body")
+	}
+	if body == nil {
+		return cls
+	}
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		child := body.NamedChild(i)
+		if child.Type() == "enum_entry" {
+			if name := languages.FirstChildOfType(child, "simple_identifier"); name != nil {
+				languages.AddChild(cls, &EnumEntry{
+					name: name.Content(content),
+					doc:  extractDoc(child, content),
+					loc:  languages.NodeRange(child),
+				})
+			}
+			continue
+		}
+		for _, member := range extractDeclaration(child, content) {
+			languages.AddChild(cls, member)
+		}
+	}
+	return cls
+}
+
+// receiverType returns the receiver type of an extension function or
+// property (the type before the "." in "fun String.shout()"), or ""
+func receiverType(node *sitter.Node, content []byte) string {
+	for i := 1; i < int(node.ChildCount()); i++ {
+		if child := node.Child(i); !child.IsNamed() && child.Type() == "." {
+			return node.Child(i - 1).Content(content)
+		}
+	}
+	return ""
+}
+
+// extractModifiers extracts annotations (by name) and modifier keywords
+func extractModifiers(node *sitter.Node, content []byte) []string {
+	mods := languages.FirstChildOfType(node, "modifiers")
+	if mods == nil {
+		return nil
+	}
+	var modifiers []string
+	for i := 0; i < int(mods.NamedChildCount()); i++ {
+		child := mods.NamedChild(i)
+		if child.Type() == "annotation" {
+			// Annotations with arguments wrap their type in a constructor invocation
+			typ := child
+			if call := languages.FirstChildOfType(child, "constructor_invocation"); call != nil {
+				typ = call
+			}
+			if name := languages.FirstChildOfType(typ, "user_type"); name != nil {
+				modifiers = append(modifiers, "@"+name.Content(content))
+			}
+			continue
+		}
+		modifiers = append(modifiers, child.Content(content))
+	}
+	return modifiers
+}
+
+// signature returns the declaration as written, from after its modifiers up to
+// the first child of one of the stop types (e.g., the body), on one line
+func signature(node *sitter.Node, content []byte, stop ...string) string {
+	start, end := node.StartByte(), node.EndByte()
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		if child.Type() == "modifiers" {
+			start = child.EndByte()
+			continue
+		}
+		if slices.Contains(stop, child.Type()) {
+			end = child.StartByte()
+			break
+		}
+	}
+	return languages.Compact(string(content[start:end]))
+}
+
+// extractDoc extracts the summary (first line) of the KDoc comment
+// (/** ... */) directly preceding a declaration
+func extractDoc(node *sitter.Node, content []byte) string {
+	prev := node.PrevNamedSibling()
+	if prev == nil || prev.Type() != "multiline_comment" || node.StartPoint().Row-prev.EndPoint().Row > 1 {
+		return ""
+	}
+
+	text := prev.Content(content)
+	if !strings.HasPrefix(text, "/**") {
+		return ""
+	}
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "/**"), "*/")
+
+	for line := range strings.SplitSeq(text, "\n") {
+		line = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*"))
+		if strings.HasPrefix(line, "@") {
+			// Block tags (@param, @return) start after the summary
+			return ""
+		}
+		if line != "" {
+			return line
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,189 @@
+package kotlin
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+	"github.com/roveo/topo-mcp/languages/languagetest"
+)
+
+func TestLanguageMetadata(t *testing.T) {
+	lang := &Language{}
+
+	if lang.Name() != "kotlin" {
+		t.Errorf("expected name 'kotlin', got %q", lang.Name())
+	}
+
+	exts := lang.Extensions()
+	if strings.Join(exts, ",") != ".kt,.kts" {
+		t.Errorf("expected extensions [.kt .kts], got %v", exts)
+	}
+}
+
+func TestParsePackageAndImports(t *testing.T) {
+	src := `package com.example.app
+
+import kotlinx.coroutines.launch
+import com.example.util.*
+import java.io.File as JFile
+`
+	lang := &Language{}
+	imports, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	expected := []string{"kotlinx.coroutines.launch", "com.example.util.*", "java.io.File"}
+	if strings.Join(imports, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected imports %v, got %v", expected, imports)
+	}
+	if len(symbols) != 1 || symbols[0].String() != "package com.example.app" {
+		t.Errorf("expected package symbol, got %v", symbols)
+	}
+}
+
+func TestParseDataClass(t *testing.T) {
+	src := `/**
+ * A user account.
+ *
+ * @property id the user id
+ */
+@Serializable
+data class User(val id: Int, var name: String = "", age: Int) : Base(), Comparable<User> {
+    val display: String get() = name
+
+    /** Compare by id. */
+    override fun compareTo(other: User): Int = id - other.id
+
+    companion object Factory {
+        const val MAX = 10
+        fun create(): User = User(0, "", 0)
+    }
+
+    inner class Inner
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 symbol, got %d", len(symbols))
+	}
+	cls, ok := symbols[0].(*Class)
+	if !ok {
+		t.Fatalf("expected *Class, got %T", symbols[0])
+	}
+	want := `@Serializable data class User(val id: Int, var name: String = "", age: Int) : Base(), Comparable<User>`
+	if cls.String() != want {
+		t.Errorf("unexpected String():\ngot:  %q\nwant: %q", cls.String(), want)
+	}
+	if cls.Kind() != "class" || cls.DocComment() != "A user account." {
+		t.Errorf("unexpected kind %q or doc comment %q", cls.Kind(), cls.DocComment())
+	}
+
+	expected := []string{
+		"val id: Int",
+		"var name: String",
+		"val display: String",
+		"override fun compareTo(other: User): Int",
+		"companion object Factory",
+		"inner class Inner",
+	}
+	got := languagetest.ChildStrings(cls)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected members:\ngot:  %q\nwant: %q", got, expected)
+	}
+
+	compareTo := cls.Children()[3].(*Function)
+	if compareTo.Kind() != "method" || compareTo.DocComment() != "Compare by id." {
+		t.Errorf("unexpected kind %q or doc comment %q", compareTo.Kind(), compareTo.DocComment())
+	}
+
+	companion := cls.Children()[4].(*Class)
+	if companion.Kind() != "companion" {
+		t.Errorf("expected kind 'companion', got %q", companion.Kind())
+	}
+	if got := languagetest.ChildStrings(companion); strings.Join(got, ",") != "const val MAX,fun create(): User" {
+		t.Errorf("unexpected companion members: %q", got)
+	}
+
+	// Companion members are addressable through their class
+	found := languages.Lookup(symbols, "User.create")
+	if len(found) != 1 || found[0].String() != "fun create(): User" {
+		t.Errorf("expected to find User.create, got %v", found)
+	}
+}
+
+func TestParseObjectsEnumsAndTopLevel(t *testing.T) {
+	src := `typealias Handler = (String) -> Unit
+
+object Registry {
+    private val users = mutableListOf<User>()
+    fun register(u: User) { users.add(u) }
+}
+
+sealed interface Shape
+
+enum class Color(val rgb: Int) {
+    RED(0xFF0000),
+    GREEN(0x00FF00);
+
+    fun hex() = ""
+}
+
+suspend fun <T> fetch(url: String): T? = null
+
+fun String.shout(): String = uppercase()
+
+val Int.double get() = this * 2
+
+private var counter = 0
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	tests := []struct {
+		kind    string
+		str     string
+		members []string
+	}{
+		{"type", "typealias Handler = (String) -> Unit", nil},
+		{"object", "object Registry", []string{"private val users", "fun register(u: User)"}},
+		{"interface", "sealed interface Shape", nil},
+		{"enum", "enum class Color(val rgb: Int)", []string{"val rgb: Int", "RED", "GREEN", "fun hex()"}},
+		{"func", "suspend fun <T> fetch(url: String): T?", nil},
+		{"func", "fun String.shout(): String", nil},
+		{"property", "val Int.double", nil},
+		{"property", "private var counter", nil},
+	}
+
+	if len(symbols) != len(tests) {
+		t.Fatalf("expected %d symbols, got %d", len(tests), len(symbols))
+	}
+	for i, tt := range tests {
+		sym := symbols[i]
+		if sym.Kind() != tt.kind {
+			t.Errorf("%s: expected kind %q, got %q", sym.Name(), tt.kind, sym.Kind())
+		}
+		if sym.String() != tt.str {
+			t.Errorf("unexpected String(): got %q, want %q", sym.String(), tt.str)
+		}
+		if got := languagetest.ChildStrings(sym); strings.Join(got, "\n") != strings.Join(tt.members, "\n") {
+			t.Errorf("%s: unexpected members %q, want %q", sym.Name(), got, tt.members)
+		}
+	}
+
+	// Extensions are addressable through their receiver type
+	for _, selector := range []string{"String.shout", "Int.double"} {
+		if found := languages.Lookup(symbols, selector); len(found) != 1 {
+			t.Errorf("expected to find %s, got %d matches", selector, len(found))
+		}
+	}
+}
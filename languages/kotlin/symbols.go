@@ -0,0 +1,145 @@
+package kotlin
+
+import (
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Package represents a Kotlin package header
+type Package struct {
+	name string
+	loc  languages.Range
+}
+
+func (p *Package) Name() string              { return p.name }
+func (p *Package) Kind() string              { return "package" }
+func (p *Package) Location() languages.Range { return p.loc }
+func (p *Package) String() string            { return "package " + p.name }
+
+// Class represents a class (including data, enum and sealed classes), an
+// interface, an object or a companion object. Members are its children.
+type Class struct {
+	languages.Nesting
+	name      string
+	kind      string   // "class", "interface", "enum", "object" or "companion"
+	modifiers []string // Annotations and modifier keywords (e.g., "@Serializable", "data")
+	signature string   // The declaration as written, up to the body (e.g., "class User(val id: Int) : Base()")
+	doc       string
+	loc       languages.Range
+}
+
+func (c *Class) Name() string              { return c.name }
+func (c *Class) Kind() string              { return c.kind }
+func (c *Class) Location() languages.Range { return c.loc }
+func (c *Class) String() string            { return withModifiers(c.modifiers, c.signature) }
+func (c *Class) DocComment() string        { return c.doc }
+
+// Function represents a function or method, including extension functions
+type Function struct {
+	languages.Nesting
+	name      string
+	receiver  string // Receiver type of an extension function (e.g., "String")
+	modifiers []string
+	signature string // e.g., "fun <T> fetch(url: String): T?"
+	doc       string
+	loc       languages.Range
+}
+
+func (f *Function) Name() string { return f.name }
+func (f *Function) Kind() string {
+	if _, ok := languages.ParentOf(f).(*Class); ok {
+		return "method"
+	}
+	return "func"
+}
+func (f *Function) Location() languages.Range { return f.loc }
+func (f *Function) String() string            { return withModifiers(f.modifiers, f.signature) }
+func (f *Function) DocComment() string        { return f.doc }
+
+// Selectors returns the name as called: "String.shout" for extension
+// functions and "User.create" for members of a companion object
+func (f *Function) Selectors() []string { return memberSelectors(f, f.receiver) }
+
+// Property represents a val or var, including extension properties and
+// properties declared in a primary constructor
+type Property struct {
+	languages.Nesting
+	name      string
+	receiver  string
+	modifiers []string
+	signature string // e.g., "val display: String"
+	doc       string
+	loc       languages.Range
+}
+
+func (p *Property) Name() string              { return p.name }
+func (p *Property) Kind() string              { return "property" }
+func (p *Property) Location() languages.Range { return p.loc }
+func (p *Property) String() string            { return withModifiers(p.modifiers, p.signature) }
+func (p *Property) DocComment() string        { return p.doc }
+func (p *Property) Selectors() []string       { return memberSelectors(p, p.receiver) }
+
+// TypeAlias represents a typealias
+type TypeAlias struct {
+	languages.Nesting
+	name      string
+	modifiers []string
+	signature string // e.g., "typealias Handler = (String) -> Unit"
+	doc       string
+	loc       languages.Range
+}
+
+func (t *TypeAlias) Name() string              { return t.name }
+func (t *TypeAlias) Kind() string              { return "type" }
+func (t *TypeAlias) Location() languages.Range { return t.loc }
+func (t *TypeAlias) String() string            { return withModifiers(t.modifiers, t.signature) }
+func (t *TypeAlias) DocComment() string        { return t.doc }
+
+// EnumEntry represents an enum entry
+type EnumEntry struct {
+	languages.Nesting
+	name string
+	doc  string
+	loc  languages.Range
+}
+
+func (e *EnumEntry) Name() string              { return e.name }
+func (e *EnumEntry) Kind() string              { return "constant" }
+func (e *EnumEntry) Location() languages.Range { return e.loc }
+func (e *EnumEntry) String() string            { return e.name }
+func (e *EnumEntry) DocComment() string        { return e.doc }
+
+// memberSelectors returns the selectors of an extension (receiver type and
+// name) or of a companion object member (enclosing class and name)
+func memberSelectors(sym languages.Symbol, receiver string) []string {
+	if receiver != "" {
+		return []string{baseName(receiver) + "." + sym.Name()}
+	}
+	companion, ok := languages.ParentOf(sym).(*Class)
+	if !ok || companion.kind != "companion" {
+		return nil
+	}
+	owner := languages.ParentOf(companion)
+	if owner == nil {
+		return nil
+	}
+	return []string{strings.Join(languages.Path(owner), ".") + "." + sym.Name()}
+}
+
+// baseName strips generic arguments and nullability from a type
+// (e.g., "List<T>?" -> "List")
+func baseName(typ string) string {
+	if idx := strings.Index(typ, "<"); idx != -1 {
+		typ = typ[:idx]
+	}
+	return strings.TrimSuffix(typ, "?")
+}
+
+// withModifiers prefixes a signature with annotations and modifier keywords
+func withModifiers(modifiers []string, signature string) string {
+	if len(modifiers) == 0 {
+		return signature
+	}
+	return strings.Join(modifiers, " ") + " " + signature
+}
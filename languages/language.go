@@ -34,6 +34,47 @@ type Documented interface {
 	DocComment() string
 }
 
+// Container is an optional interface for symbols that contain other symbols
+// (e.g., methods and nested classes of a class)
+type Container interface {
+	// Children returns the nested symbols in source order
+	Children() []Symbol
+}
+
+// Nested is an optional interface for symbols that know their enclosing symbol
+type Nested interface {
+	// Parent returns the enclosing symbol, or nil for top-level symbols
+	Parent() Symbol
+}
+
+// Addressable is an optional interface for symbols that can be addressed by
+// selectors other than their path (e.g., "(*Server).Start" for a Go method)
+type Addressable interface {
+	// Selectors returns the extra selectors, most specific first
+	Selectors() []string
+}
+
+// Scoped is an optional interface for symbols that belong to a symbol they
+// are not nested in (e.g., Go methods, declared next to their receiver type).
+// Their path has the owner's name before their own ("Server.Start").
+type Scoped interface {
+	// Scope returns the name of the owning symbol, or "" if there is none
+	Scope() string
+}
+
+// Extension is an optional interface for containers that add members to a
+// symbol declared elsewhere (e.g., Rust impl blocks, Swift extensions, SQL
+// ALTER TABLE statements). They are named after the extended symbol, so their
+// members are addressed as if they were declared in it ("Circle.area"), but the
+// container itself only answers to its Addressable selectors
+// ("impl Display for Circle"): the plain name keeps addressing the extended symbol.
+type Extension interface {
+	Addressable
+	// Extends returns the name of the extended symbol, or "" if the symbol
+	// is a declaration in its own right
+	Extends() string
+}
+
 // Language defines how to parse a particular programming language
 type Language interface {
 	// Name returns the language identifier (e.g., "go", "python")
@@ -46,6 +87,36 @@ type Language interface {
 	Parse(content []byte) (imports []string, symbols []Symbol, err error)
 }
 
+// FileParser is an optional interface for languages whose symbols depend on
+// the name of the parsed file (e.g., components named after their file)
+type FileParser interface {
+	// ParseFile is Parse for the content of the file at path
+	ParseFile(path string, content []byte) (imports []string, symbols []Symbol, err error)
+}
+
+// NamedFiles is an optional interface for languages that also handle files by
+// their exact name, regardless of extension (e.g., "Gemfile")
+type NamedFiles interface {
+	// Filenames returns the base names of the files this language handles
+	Filenames() []string
+}
+
+// Patterns is an optional interface for languages that handle files whose
+// names match glob patterns (e.g., "Dockerfile.*", "Containerfile.*"). Patterns take
+// precedence over extensions.
+type Patterns interface {
+	// Globs returns patterns matched against a file's base name, as in filepath.Match
+	Globs() []string
+}
+
+// Interpreted is an optional interface for scripting languages whose
+// extensionless files are recognized by their shebang line (e.g., "#!/bin/sh")
+// or by an editor modeline naming the interpreter (e.g., "# vim: ft=sh")
+type Interpreted interface {
+	// Interpreters returns the interpreter names this language handles (e.g., ["bash", "sh"])
+	Interpreters() []string
+}
+
 // TreeSitterLanguage is an optional interface for languages that use tree-sitter
 type TreeSitterLanguage interface {
 	Language
@@ -0,0 +1,13 @@
+// Package languagetest provides helpers for testing language parsers
+package languagetest
+
+import "github.com/roveo/topo-mcp/languages"
+
+// ChildStrings returns the rendered String() of each child of a container symbol
+func ChildStrings(sym languages.Symbol) []string {
+	var strs []string
+	for _, child := range languages.ChildrenOf(sym) {
+		strs = append(strs, child.String())
+	}
+	return strs
+}
@@ -0,0 +1,303 @@
+package lua
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/lua"
+)
+
+func init() {
+	languages.Register(&Language{})
+}
+
+// Language implements the Lua language parser.
+//
+// The grammar starts each node where the previous one ends, so node ranges
+// and identifiers at the start of a statement include the whitespace before
+// them. Ranges are trimmed with contentStart and names with text.
+type Language struct{}
+
+func (l *Language) Name() string           { return "lua" }
+func (l *Language) Extensions() []string   { return []string{".lua"} }
+func (l *Language) Interpreters() []string { return []string{"lua", "luajit"} }
+
+func (l *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(lua.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Lua file: %w", err)
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	var imports []string
+	var symbols []languages.Symbol
+	tables := map[string]*Table{}
+
+	// addFunction adds a function, under its table if the table is a local
+	// table of this file
+	addFunction := func(fn *Function, path []string) {
+		if table := tables[path[0]]; table != nil && len(path) > 1 {
+			fn.name = strings.Join(path[1:], ".")
+			languages.AddChild(table, fn)
+			return
+		}
+		symbols = append(symbols, fn)
+	}
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		child := root.NamedChild(i)
+		switch child.Type() {
+		case "function_statement":
+			fn, path := extractFunctionStatement(child, content)
+			if fn != nil {
+				addFunction(fn, path)
+			}
+		case "variable_declaration":
+			names, values := declarators(child)
+			local := firstChildOfType(child, "local") != nil
+			for j, decl := range names {
+				if j >= len(values) {
+					break
+				}
+				value := values[j]
+				path := identifiers(decl, content)
+				switch value.Type() {
+				case "function_call":
+					if imp := requiredModule(value, content); imp != "" {
+						imports = append(imports, imp)
+					}
+				case "tableconstructor":
+					if !local || len(path) != 1 {
+						continue
+					}
+					table := &Table{
+						name: path[0],
+						doc:  extractDoc(child, content),
+						loc:  nodeRange(child, content),
+					}
+					for _, field := range tableFunctions(value, table.name, content) {
+						languages.AddChild(table, field)
+					}
+					tables[table.name] = table
+					symbols = append(symbols, table)
+				case "function":
+					header := "function " + strings.Join(path, ".") + parameters(value, content)
+					if local {
+						header = "local " + header
+					}
+					addFunction(&Function{
+						name:   strings.Join(path, "."),
+						kind:   "func",
+						header: header,
+						doc:    extractDoc(child, content),
+						loc:    nodeRange(child, content),
+					}, path)
+				}
+			}
+		case "function_call":
+			if imp := requiredModule(child, content); imp != "" {
+				imports = append(imports, imp)
+			}
+		}
+	}
+
+	return imports, symbols, nil
+}
+
+// extractFunctionStatement extracts a function statement and returns it with
+// the path of its name (e.g., ["M", "setup"] for function M.setup())
+func extractFunctionStatement(node *sitter.Node, content []byte) (*Function, []string) {
+	nameNode := node.ChildByFieldName("name")
+	if nameNode == nil {
+		return nil, nil
+	}
+	path := identifiers(nameNode, content)
+	if len(path) == 0 {
+		return nil, nil
+	}
+
+	fn := &Function{
+		name:   strings.Join(path, "."),
+		kind:   "func",
+		header: "function " + text(nameNode, content) + parameters(node, content),
+		doc:    extractDoc(node, content),
+		loc:    nodeRange(node, content),
+	}
+	if firstChildOfType(node, "local") != nil {
+		fn.header = "local " + fn.header
+	}
+	if firstChildOfType(nameNode, "table_colon") != nil {
+		fn.kind = "method"
+	}
+	return fn, path
+}
+
+// tableFunctions extracts the functions defined as fields of a table
+// constructor (local M = { open = function() end })
+func tableFunctions(table *sitter.Node, tableName string, content []byte) []languages.Symbol {
+	fields := firstChildOfType(table, "fieldlist")
+	if fields == nil {
+		return nil
+	}
+	var functions []languages.Symbol
+	for i := 0; i < int(fields.NamedChildCount()); i++ {
+		field := fields.NamedChild(i)
+		name, value := field.ChildByFieldName("name"), field.ChildByFieldName("value")
+		if field.Type() != "field" || name == nil || value == nil || value.Type() != "function" {
+			continue
+		}
+		functions = append(functions, &Function{
+			name:   text(name, content),
+			kind:   "func",
+			header: "function " + tableName + "." + text(name, content) + parameters(value, content),
+			doc:    extractDoc(field, content),
+			loc:    nodeRange(field, content),
+		})
+	}
+	return functions
+}
+
+// declarators returns the declared names and the values of a variable
+// declaration or assignment, in order
+func declarators(node *sitter.Node) (names, values []*sitter.Node) {
+	for i := 0; i < int(node.ChildCount()); i++ {
+		switch node.FieldNameForChild(i) {
+		case "name":
+			names = append(names, node.Child(i))
+		case "value":
+			values = append(values, node.Child(i))
+		}
+	}
+	return names, values
+}
+
+// identifiers returns the identifiers of a dotted name (e.g., ["M", "sub", "deep"])
+func identifiers(node *sitter.Node, content []byte) []string {
+	if node.Type() == "identifier" {
+		return []string{text(node, content)}
+	}
+	var ids []string
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == "identifier" {
+			ids = append(ids, text(child, content))
+		}
+	}
+	return ids
+}
+
+// parameters returns the parameter list of a function (e.g., "(opts)")
+func parameters(node *sitter.Node, content []byte) string {
+	if params := firstChildOfType(node, "parameter_list"); params != nil {
+		return "(" + text(params, content) + ")"
+	}
+	return "()"
+}
+
+// requiredModule returns the module loaded by a require call, or ""
+func requiredModule(call *sitter.Node, content []byte) string {
+	prefix := call.ChildByFieldName("prefix")
+	if prefix == nil || text(prefix, content) != "require" {
+		return ""
+	}
+	args := call.ChildByFieldName("args")
+	if args == nil {
+		return ""
+	}
+	// require "name" passes a string_argument, require("name") a function_arguments list
+	str := args
+	if str.Type() != "string_argument" {
+		str = firstChildOfType(args, "string")
+	}
+	if str == nil {
+		return ""
+	}
+	if c := str.ChildByFieldName("content"); c != nil {
+		return c.Content(content)
+	}
+	return ""
+}
+
+// text returns the content of a node without surrounding whitespace
+func text(node *sitter.Node, content []byte) string {
+	return strings.TrimSpace(node.Content(content))
+}
+
+// nodeRange returns the range of a node from its first token, skipping
+// whitespace and documentation comments before it
+func nodeRange(node *sitter.Node, content []byte) languages.Range {
+	start := node
+	if first := node.Child(0); first != nil && first.Type() == "emmy_documentation" && node.ChildCount() > 1 {
+		start = node.Child(1)
+	}
+	r := languages.NodeRange(node)
+	r.Start = contentStart(start, content)
+	return r
+}
+
+// contentStart returns the position of the first non-whitespace byte of a node
+func contentStart(node *sitter.Node, content []byte) languages.Position {
+	pos := languages.Position{Line: int(node.StartPoint().Row), Character: int(node.StartPoint().Column)}
+	for i := node.StartByte(); i < node.EndByte(); i++ {
+		switch content[i] {
+		case '\n':
+			pos.Line++
+			pos.Character = 0
+		case ' ', '\t', '\r':
+			pos.Character++
+		default:
+			return pos
+		}
+	}
+	return pos
+}
+
+// firstChildOfType returns the first child of the given type, or nil
+func firstChildOfType(node *sitter.Node, typ string) *sitter.Node {
+	for i := 0; i < int(node.ChildCount()); i++ {
+		if child := node.Child(i); child.Type() == typ {
+			return child
+		}
+	}
+	return nil
+}
+
+// extractDoc extracts the first line of a declaration's documentation: its
+// LuaDoc comments (---) or the -- comments directly preceding it. Annotation
+// lines (---@param) are skipped.
+func extractDoc(node *sitter.Node, content []byte) string {
+	var lines []string
+	if doc := firstChildOfType(node, "emmy_documentation"); doc != nil {
+		lines = strings.Split(doc.Content(content), "\n")
+	} else {
+		// Comments before the first field of a table precede the field list
+		first := node.PrevNamedSibling()
+		if parent := node.Parent(); first == nil && parent != nil && parent.Type() == "fieldlist" {
+			first = parent.PrevNamedSibling()
+		}
+		expectedRow := contentStart(node, content).Line
+		for prev := first; prev != nil && prev.Type() == "comment"; prev = prev.PrevNamedSibling() {
+			if int(prev.EndPoint().Row)+1 != expectedRow {
+				break
+			}
+			lines = append(strings.Split(text(prev, content), "\n"), lines...)
+			expectedRow = contentStart(prev, content).Line
+		}
+	}
+
+	for _, line := range lines {
+		line = strings.TrimSpace(strings.Trim(strings.TrimSpace(line), "-[]"))
+		if line != "" && !strings.HasPrefix(line, "@") {
+			return line
+		}
+	}
+	return ""
+}
@@ -0,0 +1,158 @@
+package lua
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+	"github.com/roveo/topo-mcp/languages/languagetest"
+)
+
+func TestLanguageMetadata(t *testing.T) {
+	lang := &Language{}
+
+	if lang.Name() != "lua" {
+		t.Errorf("expected name 'lua', got %q", lang.Name())
+	}
+
+	exts := lang.Extensions()
+	if len(exts) != 1 || exts[0] != ".lua" {
+		t.Errorf("expected extensions [.lua], got %v", exts)
+	}
+}
+
+func TestParseModule(t *testing.T) {
+	src := `--- Plugin entry point
+local M = {
+  -- Opens the panel
+  open = function(buf) end,
+  name = "panel",
+}
+
+local util = require("panel.util")
+require "panel.highlights"
+
+local config = {
+  enabled = true,
+}
+
+-- Set up the plugin
+function M.setup(opts)
+  config = vim.tbl_extend("force", config, opts or {})
+end
+
+---Closes the panel
+---@param force boolean
+function M:close(force)
+end
+
+M.toggle = function()
+  config.enabled = not config.enabled
+end
+
+local function helper(x)
+  return x
+end
+
+function global_fn() end
+
+local count = 0
+return M
+`
+	lang := &Language{}
+	imports, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if strings.Join(imports, ",") != "panel.util,panel.highlights" {
+		t.Errorf("expected imports [panel.util panel.highlights], got %v", imports)
+	}
+
+	tests := []struct {
+		kind    string
+		str     string
+		doc     string
+		members []string
+	}{
+		{"table", "local M", "Plugin entry point", []string{
+			"function M.open(buf)",
+			"function M.setup(opts)",
+			"function M:close(force)",
+			"function M.toggle()",
+		}},
+		{"table", "local config", "", nil},
+		{"func", "local function helper(x)", "", nil},
+		{"func", "function global_fn()", "", nil},
+	}
+
+	if len(symbols) != len(tests) {
+		t.Fatalf("expected %d symbols, got %d", len(tests), len(symbols))
+	}
+	for i, tt := range tests {
+		sym := symbols[i]
+		if sym.Kind() != tt.kind || sym.String() != tt.str {
+			t.Errorf("expected %s %q, got %s %q", tt.kind, tt.str, sym.Kind(), sym.String())
+		}
+		if doc := sym.(languages.Documented).DocComment(); doc != tt.doc {
+			t.Errorf("%s: expected doc comment %q, got %q", sym.Name(), tt.doc, doc)
+		}
+		members := languagetest.ChildStrings(sym)
+		if strings.Join(members, "|") != strings.Join(tt.members, "|") {
+			t.Errorf("%s: expected members %v, got %v", sym.Name(), tt.members, members)
+		}
+	}
+
+	docs := map[string]string{
+		"M.open":  "Opens the panel",
+		"M.setup": "Set up the plugin",
+		"M.close": "Closes the panel",
+	}
+	for selector, want := range docs {
+		found := languages.Lookup(symbols, selector)
+		if len(found) != 1 {
+			t.Errorf("expected to find %s, got %d matches", selector, len(found))
+			continue
+		}
+		if doc := found[0].(*Function).DocComment(); doc != want {
+			t.Errorf("%s: expected doc comment %q, got %q", selector, want, doc)
+		}
+	}
+
+	if kind := languages.Lookup(symbols, "M.close")[0].Kind(); kind != "method" {
+		t.Errorf("expected M:close to be a method, got %s", kind)
+	}
+
+	// Ranges start at the declaration, not at the whitespace or doc comments before it
+	setup := languages.Lookup(symbols, "M.setup")[0]
+	if loc := setup.Location(); loc.Start.Line != 15 || loc.Start.Character != 0 || loc.End.Line != 17 {
+		t.Errorf("expected M.setup on lines 15-17 from column 0, got %d:%d-%d", loc.Start.Line, loc.Start.Character, loc.End.Line)
+	}
+	closeFn := languages.Lookup(symbols, "M.close")[0]
+	if loc := closeFn.Location(); loc.Start.Line != 21 || loc.End.Line != 22 {
+		t.Errorf("expected M.close on lines 21-22, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+}
+
+func TestParseFunctionsWithoutLocalTable(t *testing.T) {
+	src := `function vim.g.my_helper() end
+local handler = function(event) end
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	want := []string{"function vim.g.my_helper()", "local function handler(event)"}
+	if len(symbols) != len(want) {
+		t.Fatalf("expected %d symbols, got %d", len(want), len(symbols))
+	}
+	for i, str := range want {
+		if symbols[i].String() != str {
+			t.Errorf("expected %q, got %q", str, symbols[i].String())
+		}
+	}
+	if symbols[0].Name() != "vim.g.my_helper" {
+		t.Errorf("expected name vim.g.my_helper, got %q", symbols[0].Name())
+	}
+}
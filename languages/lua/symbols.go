@@ -0,0 +1,37 @@
+package lua
+
+import (
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Function represents a function declaration or a function assigned to a
+// name. Functions of a local table (M.setup, M:close) are children of the table.
+type Function struct {
+	languages.Nesting
+	name   string
+	kind   string // "func", or "method" for functions declared with a colon (M:close)
+	header string // e.g., "local function helper(x)", "function M.setup(opts)"
+	doc    string
+	loc    languages.Range
+}
+
+func (f *Function) Name() string              { return f.name }
+func (f *Function) Kind() string              { return f.kind }
+func (f *Function) Location() languages.Range { return f.loc }
+func (f *Function) String() string            { return f.header }
+func (f *Function) DocComment() string        { return f.doc }
+
+// Table represents a local variable initialized with a table constructor,
+// typically a module table (local M = {}). Its functions are its children.
+type Table struct {
+	languages.Nesting
+	name string
+	doc  string
+	loc  languages.Range
+}
+
+func (t *Table) Name() string              { return t.name }
+func (t *Table) Kind() string              { return "table" }
+func (t *Table) Location() languages.Range { return t.loc }
+func (t *Table) String() string            { return "local " + t.name }
+func (t *Table) DocComment() string        { return t.doc }
@@ -0,0 +1,232 @@
+package makefile
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+func init() {
+	languages.Register(&Language{})
+}
+
+// Language implements the Makefile parser. There is no tree-sitter grammar
+// for make, so makefiles are parsed line by line.
+type Language struct{}
+
+func (l *Language) Name() string         { return "makefile" }
+func (l *Language) Extensions() []string { return []string{".mk"} }
+func (l *Language) Filenames() []string {
+	return []string{"Makefile", "makefile", "GNUmakefile"}
+}
+
+// Interpreters returns "make" for executable makefiles ("#!/usr/bin/make -f")
+func (l *Language) Interpreters() []string { return []string{"make"} }
+
+// maxValueLen is the longest variable value shown in a variable's String()
+const maxValueLen = 60
+
+// assignment matches a variable assignment, with optional modifiers
+var assignment = regexp.MustCompile(`^(?:(?:export|override|private)\s+)*([^\s:#=]+)\s*(:::=|::=|:=|\?=|\+=|!=|=)\s*(.*)$`)
+
+// specialTarget matches built-in targets such as .PHONY and .DEFAULT
+var specialTarget = regexp.MustCompile(`^\.[A-Z_]+$`)
+
+// directives are the keywords of lines that are neither rules nor assignments
+var directives = map[string]bool{
+	"ifeq": true, "ifneq": true, "ifdef": true, "ifndef": true, "else": true, "endif": true,
+	"export": true, "unexport": true, "undefine": true, "vpath": true,
+}
+
+// Parse extracts targets, variables and included files. A target's range
+// spans its rule line and its recipe.
+func (l *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	lines := strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+
+	var (
+		imports   []string
+		symbols   []languages.Symbol
+		targets   []*Target
+		variables = make(map[string]bool)
+		phony     = make(map[string]bool)
+		rule      []*Target // Targets of the rule whose recipe is being read
+		define    *Variable // Define block being read
+		comments  []string  // Comment lines directly above the current line
+		commentAt = -1      // Line of the last comment
+	)
+
+	for start := 0; start < len(lines); start++ {
+		end, text := logicalLine(lines, start)
+		line := start
+		start = end
+
+		if define != nil {
+			if strings.HasPrefix(strings.TrimSpace(text), "endef") {
+				define.loc.End = languages.Position{Line: end, Character: len(lines[end])}
+				define = nil
+			}
+			continue
+		}
+
+		// Recipe lines start with a tab
+		if strings.HasPrefix(text, "\t") {
+			for _, t := range rule {
+				t.loc.End = languages.Position{Line: end, Character: len(lines[end])}
+			}
+			continue
+		}
+
+		trimmed := strings.TrimSpace(text)
+		if trimmed == "" {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "#") {
+			if commentAt != line-1 {
+				comments = nil
+			}
+			comments = append(comments, trimmed)
+			commentAt = end
+			continue
+		}
+
+		doc := ""
+		if commentAt == line-1 {
+			doc = firstCommentLine(comments)
+		}
+		comments, commentAt = nil, -1
+		rule = nil
+
+		loc := languages.Range{
+			Start: languages.Position{Line: line, Character: len(text) - len(strings.TrimLeft(text, " "))},
+			End:   languages.Position{Line: end, Character: len(lines[end])},
+		}
+		code, comment := stripComment(trimmed)
+		fields := strings.Fields(code)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch {
+		case fields[0] == "include" || fields[0] == "-include" || fields[0] == "sinclude":
+			imports = append(imports, fields[1:]...)
+		case fields[0] == "define" && len(fields) > 1:
+			define = &Variable{name: fields[1], op: "define", doc: doc, loc: loc}
+			symbols = append(symbols, define)
+		case assignment.MatchString(code):
+			m := assignment.FindStringSubmatch(code)
+			if m[2] == "+=" && variables[m[1]] {
+				// Appending to a variable defined above
+				continue
+			}
+			variables[m[1]] = true
+			symbols = append(symbols, &Variable{name: m[1], op: m[2], value: shorten(m[3]), doc: doc, loc: loc})
+		case directives[fields[0]]:
+		default:
+			idx := indexOutsideRefs(code, ':')
+			if idx == -1 {
+				continue
+			}
+			prereqs := strings.TrimPrefix(code[idx+1:], ":")
+			if semi := indexOutsideRefs(prereqs, ';'); semi != -1 {
+				// Inline recipe
+				prereqs = prereqs[:semi]
+			}
+			if assignment.MatchString(strings.TrimSpace(prereqs)) {
+				// Target-specific variable
+				continue
+			}
+			prereqs = strings.Join(strings.Fields(prereqs), " ")
+
+			// Self-documenting makefiles describe targets with a ## comment
+			if strings.HasPrefix(comment, "##") {
+				doc = strings.TrimSpace(strings.TrimLeft(comment, "#"))
+			}
+
+			for _, name := range strings.Fields(code[:idx]) {
+				if specialTarget.MatchString(name) {
+					if name == ".PHONY" {
+						for _, p := range strings.Fields(prereqs) {
+							phony[p] = true
+						}
+					}
+					continue
+				}
+				target := &Target{name: name, prereqs: prereqs, doc: doc, loc: loc}
+				rule = append(rule, target)
+				targets = append(targets, target)
+				symbols = append(symbols, target)
+			}
+		}
+	}
+
+	for _, t := range targets {
+		t.phony = phony[t.name]
+	}
+
+	return imports, symbols, nil
+}
+
+// logicalLine joins a line with the lines it continues onto with a trailing
+// backslash. Returns the last physical line and the joined text.
+func logicalLine(lines []string, start int) (int, string) {
+	text := lines[start]
+	end := start
+	for strings.HasSuffix(text, "\\") && !strings.HasSuffix(text, "\\\\") && end+1 < len(lines) {
+		end++
+		text = strings.TrimRight(strings.TrimSuffix(text, "\\"), " \t") + " " + strings.TrimSpace(lines[end])
+	}
+	return end, text
+}
+
+// stripComment splits a line into its code and its # comment
+func stripComment(line string) (string, string) {
+	for i := 0; i < len(line); i++ {
+		if line[i] == '#' && (i == 0 || line[i-1] != '\\') {
+			return strings.TrimSpace(line[:i]), line[i:]
+		}
+	}
+	return line, ""
+}
+
+// indexOutsideRefs returns the index of the first c outside variable
+// references ($(...) and ${...}), or -1
+func indexOutsideRefs(s string, c byte) int {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(', '{':
+			if depth > 0 || (i > 0 && s[i-1] == '$') {
+				depth++
+			}
+		case ')', '}':
+			if depth > 0 {
+				depth--
+			}
+		case c:
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// shorten returns a variable value for display, cut to maxValueLen
+func shorten(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) > maxValueLen {
+		return value[:maxValueLen-3] + "..."
+	}
+	return value
+}
+
+// firstCommentLine returns the first non-empty line of a comment block
+func firstCommentLine(comments []string) string {
+	for _, line := range comments {
+		if line = strings.TrimSpace(strings.TrimLeft(line, "#")); line != "" {
+			return line
+		}
+	}
+	return ""
+}
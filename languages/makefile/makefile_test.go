@@ -0,0 +1,145 @@
+package makefile
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+func TestLanguageMetadata(t *testing.T) {
+	lang := &Language{}
+
+	if lang.Name() != "makefile" {
+		t.Errorf("expected name 'makefile', got %q", lang.Name())
+	}
+
+	exts := lang.Extensions()
+	if len(exts) != 1 || exts[0] != ".mk" {
+		t.Errorf("expected extensions [.mk], got %v", exts)
+	}
+
+	names := lang.Filenames()
+	if strings.Join(names, ",") != "Makefile,makefile,GNUmakefile" {
+		t.Errorf("expected filenames [Makefile makefile GNUmakefile], got %v", names)
+	}
+}
+
+func TestParse(t *testing.T) {
+	src := `include common.mk
+-include .env
+
+# Go compiler
+GO ?= go
+LDFLAGS := -s -w \
+	-X main.version=$(VERSION)
+LDFLAGS += -X main.commit=$(COMMIT)
+
+.PHONY: build test clean
+
+# Build the binary
+build: $(BIN)/app ## Build everything
+	$(GO) build -ldflags "$(LDFLAGS)" ./...
+
+	@echo built
+
+$(BIN)/app: main.go | $(BIN)
+	$(GO) build -o $@ .
+
+test: build
+	$(GO) test ./...
+test: GOFLAGS = -race
+
+%.o: %.c ; $(CC) -c $<
+
+define HELP
+usage: make build
+endef
+
+ifeq ($(OS),Windows_NT)
+EXE := .exe
+endif
+
+# Remove build output
+clean:
+	rm -rf $(BIN)
+`
+	lang := &Language{}
+	imports, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if strings.Join(imports, ",") != "common.mk,.env" {
+		t.Errorf("expected imports [common.mk .env], got %v", imports)
+	}
+
+	tests := []struct {
+		name      string
+		kind      string
+		str       string
+		doc       string
+		startLine int
+		endLine   int
+	}{
+		{"GO", "var", "GO ?= go", "Go compiler", 4, 4},
+		{"LDFLAGS", "var", "LDFLAGS := -s -w -X main.version=$(VERSION)", "", 5, 6},
+		{"build", "phony", "build: $(BIN)/app", "Build everything", 12, 15},
+		{"$(BIN)/app", "target", "$(BIN)/app: main.go | $(BIN)", "", 17, 18},
+		{"test", "phony", "test: build", "", 20, 21},
+		{"%.o", "target", "%.o: %.c", "", 24, 24},
+		{"HELP", "var", "define HELP", "", 26, 28},
+		{"EXE", "var", "EXE := .exe", "", 31, 31},
+		{"clean", "phony", "clean:", "Remove build output", 35, 36},
+	}
+
+	if len(symbols) != len(tests) {
+		for _, s := range symbols {
+			t.Logf("  %s: %s", s.Kind(), s.String())
+		}
+		t.Fatalf("expected %d symbols, got %d", len(tests), len(symbols))
+	}
+
+	for i, tt := range tests {
+		sym := symbols[i]
+		if sym.Name() != tt.name {
+			t.Errorf("symbol %d: expected name %q, got %q", i, tt.name, sym.Name())
+		}
+		if sym.Kind() != tt.kind {
+			t.Errorf("%s: expected kind %q, got %q", tt.name, tt.kind, sym.Kind())
+		}
+		if sym.String() != tt.str {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.str, sym.String())
+		}
+		if doc := sym.(languages.Documented).DocComment(); doc != tt.doc {
+			t.Errorf("%s: expected doc %q, got %q", tt.name, tt.doc, doc)
+		}
+		loc := sym.Location()
+		if loc.Start.Line != tt.startLine || loc.End.Line != tt.endLine {
+			t.Errorf("%s: expected lines %d-%d, got %d-%d", tt.name, tt.startLine, tt.endLine, loc.Start.Line, loc.End.Line)
+		}
+	}
+}
+
+func TestParseMultipleTargets(t *testing.T) {
+	src := `all lint: deps
+	@echo $@
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 2 {
+		t.Fatalf("expected 2 symbols, got %d", len(symbols))
+	}
+	for i, name := range []string{"all", "lint"} {
+		if symbols[i].Name() != name || symbols[i].String() != name+": deps" {
+			t.Errorf("expected target %s: deps, got %q", name, symbols[i].String())
+		}
+		if loc := symbols[i].Location(); loc.Start.Line != 0 || loc.End.Line != 1 {
+			t.Errorf("%s: expected lines 0-1, got %d-%d", name, loc.Start.Line, loc.End.Line)
+		}
+	}
+}
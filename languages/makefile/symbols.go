@@ -0,0 +1,59 @@
+package makefile
+
+import (
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Target represents a rule for a single target. A rule with several targets
+// yields one Target per name, sharing the rule's range.
+type Target struct {
+	languages.Nesting
+	name    string
+	prereqs string // Prerequisites as written (e.g., "fmt vet | bin")
+	phony   bool   // Listed as a prerequisite of .PHONY
+	doc     string
+	loc     languages.Range
+}
+
+func (t *Target) Name() string { return t.name }
+
+// Kind returns "phony" for targets listed in .PHONY, "target" otherwise
+func (t *Target) Kind() string {
+	if t.phony {
+		return "phony"
+	}
+	return "target"
+}
+
+func (t *Target) Location() languages.Range { return t.loc }
+func (t *Target) String() string {
+	if t.prereqs == "" {
+		return t.name + ":"
+	}
+	return t.name + ": " + t.prereqs
+}
+func (t *Target) DocComment() string { return t.doc }
+
+// Variable represents a variable assignment or a define block
+type Variable struct {
+	languages.Nesting
+	name  string
+	op    string // Assignment operator (e.g., "=", ":=", "?="), or "define"
+	value string // Short value as written, if any
+	doc   string
+	loc   languages.Range
+}
+
+func (v *Variable) Name() string              { return v.name }
+func (v *Variable) Kind() string              { return "var" }
+func (v *Variable) Location() languages.Range { return v.loc }
+func (v *Variable) String() string {
+	if v.op == "define" {
+		return "define " + v.name
+	}
+	if v.value == "" {
+		return v.name + " " + v.op
+	}
+	return v.name + " " + v.op + " " + v.value
+}
+func (v *Variable) DocComment() string { return v.doc }
@@ -24,6 +24,7 @@ func (l *Language) Extensions() []string {
 // Parse parses markdown content and extracts headings as symbols.
 // Each heading's range extends from its line to just before the next heading
 // at the same or higher level (fewer #s), or to the end of the file.
+// Subheadings are children of the heading whose section contains them.
 func (l *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
 	lines := strings.Split(string(content), "\n")
 
@@ -59,9 +60,11 @@ func (l *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
 		}
 	}
 
-	// Second pass: calculate end lines for each heading
+	// Second pass: calculate end lines for each heading and nest it under
+	// the closest preceding heading of a higher level (fewer #s).
 	// A heading's range ends when we encounter a heading at the same or higher level
 	var symbols []languages.Symbol
+	var stack []*Heading
 
 	for i, h := range headings {
 		endLine := len(lines) - 1 // Default to end of file
@@ -81,14 +84,24 @@ func (l *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
 			endChar = len(lines[endLine])
 		}
 
-		symbols = append(symbols, &Heading{
+		heading := &Heading{
 			name:  h.text,
 			level: h.level,
 			loc: languages.Range{
 				Start: languages.Position{Line: h.line, Character: 0},
 				End:   languages.Position{Line: endLine, Character: endChar},
 			},
-		})
+		}
+
+		for len(stack) > 0 && stack[len(stack)-1].level >= h.level {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) > 0 {
+			languages.AddChild(stack[len(stack)-1], heading)
+		} else {
+			symbols = append(symbols, heading)
+		}
+		stack = append(stack, heading)
 	}
 
 	return nil, symbols, nil
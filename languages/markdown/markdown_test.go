@@ -2,8 +2,22 @@ package markdown
 
 import (
 	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
 )
 
+// parseHeadings parses src and returns all headings in document order.
+// Subheadings are nested in the parse result, so they are flattened here.
+func parseHeadings(t *testing.T, src string) []languages.Symbol {
+	t.Helper()
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	return languages.Flatten(symbols)
+}
+
 func TestLanguageMetadata(t *testing.T) {
 	lang := &Language{}
 
@@ -77,11 +91,7 @@ More content.
 
 Chapter 2 content.
 `
-	lang := &Language{}
-	_, symbols, err := lang.Parse([]byte(src))
-	if err != nil {
-		t.Fatalf("Parse failed: %v", err)
-	}
+	symbols := parseHeadings(t, src)
 
 	if len(symbols) != 4 {
 		t.Fatalf("expected 4 symbols, got %d", len(symbols))
@@ -135,11 +145,7 @@ func TestParseHeadingLevels(t *testing.T) {
 ##### H5
 ###### H6
 `
-	lang := &Language{}
-	_, symbols, err := lang.Parse([]byte(src))
-	if err != nil {
-		t.Fatalf("Parse failed: %v", err)
-	}
+	symbols := parseHeadings(t, src)
 
 	if len(symbols) != 6 {
 		t.Fatalf("expected 6 symbols, got %d", len(symbols))
@@ -165,11 +171,7 @@ Some text
 
 # Another Title
 `
-	lang := &Language{}
-	_, symbols, err := lang.Parse([]byte(src))
-	if err != nil {
-		t.Fatalf("Parse failed: %v", err)
-	}
+	symbols := parseHeadings(t, src)
 
 	if len(symbols) != 4 {
 		t.Fatalf("expected 4 symbols, got %d", len(symbols))
@@ -324,11 +326,7 @@ func TestMoreThanSixHashes(t *testing.T) {
 func TestIgnoreCodeBlocks(t *testing.T) {
 	src := "# Real Heading\n\n```bash\n# This is a comment\n## Not a heading\n```\n\n## Another Real Heading\n"
 
-	lang := &Language{}
-	_, symbols, err := lang.Parse([]byte(src))
-	if err != nil {
-		t.Fatalf("Parse failed: %v", err)
-	}
+	symbols := parseHeadings(t, src)
 
 	if len(symbols) != 2 {
 		t.Fatalf("expected 2 symbols, got %d", len(symbols))
@@ -345,11 +343,7 @@ func TestIgnoreCodeBlocks(t *testing.T) {
 func TestIgnoreTildeCodeBlocks(t *testing.T) {
 	src := "# Heading\n\n~~~\n# Comment in code\n~~~\n\n## Subheading\n"
 
-	lang := &Language{}
-	_, symbols, err := lang.Parse([]byte(src))
-	if err != nil {
-		t.Fatalf("Parse failed: %v", err)
-	}
+	symbols := parseHeadings(t, src)
 
 	if len(symbols) != 2 {
 		t.Fatalf("expected 2 symbols, got %d", len(symbols))
@@ -372,11 +366,7 @@ Content
 
 More content
 `
-	lang := &Language{}
-	_, symbols, err := lang.Parse([]byte(src))
-	if err != nil {
-		t.Fatalf("Parse failed: %v", err)
-	}
+	symbols := parseHeadings(t, src)
 
 	if len(symbols) != 2 {
 		t.Fatalf("expected 2 symbols, got %d", len(symbols))
@@ -397,3 +387,41 @@ More content
 		t.Errorf("Second: expected start line 4, got %d", second.Location().Start.Line)
 	}
 }
+
+func TestParseHeadingHierarchy(t *testing.T) {
+	src := `## Preface
+
+# Chapter 1
+
+## Section 1.1
+
+### Detail
+
+## Section 1.2
+
+# Chapter 2
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	// A subheading before any H1 stays top-level
+	if len(symbols) != 3 {
+		t.Fatalf("expected 3 top-level headings, got %d", len(symbols))
+	}
+
+	ch1 := symbols[1].(*Heading)
+	if len(ch1.Children()) != 2 {
+		t.Fatalf("expected Chapter 1 to have 2 sections, got %d", len(ch1.Children()))
+	}
+
+	detail := languages.ChildrenOf(ch1.Children()[0])[0]
+	if got := languages.QualifiedName(detail); got != "Chapter 1.Section 1.1.Detail" {
+		t.Errorf("unexpected qualified name %q", got)
+	}
+	if !languages.MatchesSelector(detail, "Chapter 1/Section 1.1/Detail") {
+		t.Error("expected slash-separated path to match")
+	}
+}
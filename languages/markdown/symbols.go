@@ -7,8 +7,10 @@ import (
 	"github.com/roveo/topo-mcp/languages"
 )
 
-// Heading represents a Markdown heading (# to ######)
+// Heading represents a Markdown heading (# to ######).
+// Subheadings within its section are its children.
 type Heading struct {
+	languages.Nesting
 	name  string          // The heading text
 	level int             // 1-6 for # to ######
 	loc   languages.Range // Range includes everything under this heading
@@ -0,0 +1,90 @@
+package languages
+
+import "strings"
+
+// Nesting implements Container and Nested. Symbol types embed it to take part
+// in the symbol hierarchy, and parsers link symbols together with AddChild.
+type Nesting struct {
+	parent   Symbol
+	children []Symbol
+}
+
+func (n *Nesting) Parent() Symbol     { return n.parent }
+func (n *Nesting) Children() []Symbol { return n.children }
+func (n *Nesting) nesting() *Nesting  { return n }
+
+// nester is implemented by every type that embeds Nesting
+type nester interface {
+	nesting() *Nesting
+}
+
+// AddChild appends child to the children of parent and records parent as the
+// child's enclosing symbol. Symbols that don't embed Nesting are left unchanged.
+func AddChild(parent, child Symbol) {
+	if p, ok := parent.(nester); ok {
+		p.nesting().children = append(p.nesting().children, child)
+	}
+	if c, ok := child.(nester); ok {
+		c.nesting().parent = parent
+	}
+}
+
+// ChildrenOf returns the children of a symbol, or nil if it isn't a Container
+func ChildrenOf(sym Symbol) []Symbol {
+	if c, ok := sym.(Container); ok {
+		return c.Children()
+	}
+	return nil
+}
+
+// ParentOf returns the enclosing symbol, or nil for top-level symbols
+func ParentOf(sym Symbol) Symbol {
+	if n, ok := sym.(Nested); ok {
+		return n.Parent()
+	}
+	return nil
+}
+
+// Walk visits symbols and all their descendants depth-first in source order.
+// Depth is 0 for the given symbols and increases by one per nesting level.
+func Walk(symbols []Symbol, fn func(sym Symbol, depth int)) {
+	var walk func(syms []Symbol, depth int)
+	walk = func(syms []Symbol, depth int) {
+		for _, sym := range syms {
+			fn(sym, depth)
+			walk(ChildrenOf(sym), depth+1)
+		}
+	}
+	walk(symbols, 0)
+}
+
+// Flatten returns symbols and all their descendants in source order
+func Flatten(symbols []Symbol) []Symbol {
+	var flat []Symbol
+	Walk(symbols, func(sym Symbol, _ int) {
+		flat = append(flat, sym)
+	})
+	return flat
+}
+
+// Path returns the names of a symbol's ancestors followed by its own name,
+// outermost first (e.g., ["Server", "handle_request"]). The scope of a Scoped
+// symbol comes right before its name.
+func Path(sym Symbol) []string {
+	var path []string
+	for s := sym; s != nil; s = ParentOf(s) {
+		path = append(path, s.Name())
+		if scoped, ok := s.(Scoped); ok && scoped.Scope() != "" {
+			path = append(path, scoped.Scope())
+		}
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// QualifiedName returns the dot-separated path of a symbol (e.g., "Server.handle_request")
+func QualifiedName(sym Symbol) string {
+	return strings.Join(Path(sym), ".")
+}
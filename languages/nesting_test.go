@@ -0,0 +1,83 @@
+package languages
+
+import (
+	"strings"
+	"testing"
+)
+
+// mockNode is a test Symbol that takes part in the hierarchy
+type mockNode struct {
+	Nesting
+	name string
+}
+
+func (m *mockNode) Name() string    { return m.name }
+func (m *mockNode) Kind() string    { return "node" }
+func (m *mockNode) Location() Range { return Range{} }
+func (m *mockNode) String() string  { return m.name }
+
+func buildTree() []Symbol {
+	server := &mockNode{name: "Server"}
+	handler := &mockNode{name: "Handler"}
+	AddChild(server, &mockNode{name: "start"})
+	AddChild(server, handler)
+	AddChild(handler, &mockNode{name: "run"})
+	return []Symbol{server, &mockNode{name: "main"}}
+}
+
+func TestAddChild(t *testing.T) {
+	parent := &mockNode{name: "parent"}
+	child := &mockNode{name: "child"}
+	AddChild(parent, child)
+
+	if len(parent.Children()) != 1 || parent.Children()[0] != child {
+		t.Errorf("expected child to be added to parent, got %v", parent.Children())
+	}
+	if child.Parent() != parent {
+		t.Errorf("expected child's parent to be set")
+	}
+	if ParentOf(parent) != nil {
+		t.Errorf("expected top-level symbol to have no parent")
+	}
+}
+
+func TestWalk(t *testing.T) {
+	var visited []string
+	Walk(buildTree(), func(sym Symbol, depth int) {
+		visited = append(visited, strings.Repeat(">", depth)+sym.Name())
+	})
+
+	expected := "Server >start >Handler >>run main"
+	if got := strings.Join(visited, " "); got != expected {
+		t.Errorf("Walk visited %q, want %q", got, expected)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	flat := Flatten(buildTree())
+	if len(flat) != 5 {
+		t.Errorf("expected 5 symbols, got %d", len(flat))
+	}
+}
+
+func TestQualifiedName(t *testing.T) {
+	flat := Flatten(buildTree())
+
+	tests := []struct {
+		index int
+		want  string
+	}{
+		{0, "Server"},
+		{1, "Server.start"},
+		{3, "Server.Handler.run"},
+		{4, "main"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := QualifiedName(flat[tt.index]); got != tt.want {
+				t.Errorf("QualifiedName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
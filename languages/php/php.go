@@ -0,0 +1,402 @@
+package php
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/php"
+)
+
+func init() {
+	languages.Register(&Language{})
+}
+
+// Language implements the PHP language parser
+type Language struct{}
+
+func (l *Language) Name() string           { return "php" }
+func (l *Language) Extensions() []string   { return []string{".php"} }
+func (l *Language) Interpreters() []string { return []string{"php"} }
+
+func (l *Language) TreeSitterLang() *sitter.Language {
+	return php.GetLanguage()
+}
+
+func (l *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(php.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse PHP file: %w", err)
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	var imports []string
+	var symbols []languages.Symbol
+	var fileNamespace *Namespace
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		child := root.NamedChild(i)
+		switch child.Type() {
+		case "namespace_use_declaration":
+			imports = append(imports, extractUse(child, content)...)
+		case "namespace_definition":
+			ns := &Namespace{
+				name: languages.FieldContent(child, "name", content),
+				loc:  languages.NodeRange(child),
+			}
+			symbols = append(symbols, ns)
+			body := child.ChildByFieldName("body")
+			if body == nil {
+				// Declarations following "namespace Foo;" belong to it
+				fileNamespace = ns
+				continue
+			}
+			fileNamespace = nil
+			for j := 0; j < int(body.NamedChildCount()); j++ {
+				stmt := body.NamedChild(j)
+				if stmt.Type() == "namespace_use_declaration" {
+					imports = append(imports, extractUse(stmt, content)...)
+					continue
+				}
+				for _, sym := range extractStatement(stmt, content) {
+					languages.AddChild(ns, sym)
+				}
+			}
+		default:
+			for _, sym := range extractStatement(child, content) {
+				if fileNamespace == nil {
+					symbols = append(symbols, sym)
+					continue
+				}
+				languages.AddChild(fileNamespace, sym)
+				fileNamespace.loc.End = sym.Location().End
+			}
+		}
+	}
+
+	return imports, symbols, nil
+}
+
+// extractUse extracts the names imported by a use declaration, including
+// grouped uses ("use App\{Foo, Bar}"). Aliases are recorded by their target.
+func extractUse(node *sitter.Node, content []byte) []string {
+	var imports []string
+	prefix := ""
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "namespace_name":
+			prefix = child.Content(content) + `\`
+		case "namespace_use_clause":
+			if name := useClauseName(child, content); name != "" {
+				imports = append(imports, strings.TrimPrefix(name, `\`))
+			}
+		case "namespace_use_group":
+			for j := 0; j < int(child.NamedChildCount()); j++ {
+				if name := useClauseName(child.NamedChild(j), content); name != "" {
+					imports = append(imports, strings.TrimPrefix(prefix+name, `\`))
+				}
+			}
+		}
+	}
+
+	return imports
+}
+
+// useClauseName returns the imported name of a use clause, without its alias
+func useClauseName(clause *sitter.Node, content []byte) string {
+	for i := 0; i < int(clause.NamedChildCount()); i++ {
+		switch child := clause.NamedChild(i); child.Type() {
+		case "qualified_name", "namespace_name", "name":
+			return child.Content(content)
+		}
+	}
+	return ""
+}
+
+// extractStatement extracts the symbols declared by a top-level statement.
+// Declarations guarded by an if statement (e.g., "if (!function_exists('x'))",
+// common in WordPress plugins) are extracted as well.
+func extractStatement(node *sitter.Node, content []byte) []languages.Symbol {
+	switch node.Type() {
+	case "class_declaration", "interface_declaration", "trait_declaration", "enum_declaration":
+		return []languages.Symbol{extractType(node, content)}
+	case "function_definition":
+		return []languages.Symbol{extractFunction(node, content, "func")}
+	case "const_declaration":
+		return extractConstants(node, content)
+	case "expression_statement":
+		if define := extractDefine(node, content); define != nil {
+			return []languages.Symbol{define}
+		}
+	case "if_statement":
+		var symbols []languages.Symbol
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			if body := node.NamedChild(i); body.Type() == "compound_statement" || body.Type() == "colon_block" {
+				for j := 0; j < int(body.NamedChildCount()); j++ {
+					symbols = append(symbols, extractStatement(body.NamedChild(j), content)...)
+				}
+			}
+		}
+		return symbols
+	}
+	return nil
+}
+
+// extractType extracts a class, interface, trait or enum with its members
+func extractType(node *sitter.Node, content []byte) *Type {
+	typ := &Type{
+		name:      languages.FieldContent(node, "name", content),
+		keyword:   strings.TrimSuffix(node.Type(), "_declaration"),
+		modifiers: extractModifiers(node, content),
+		doc:       extractDoc(node, content),
+		loc:       languages.NodeRange(node),
+	}
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "primitive_type":
+			typ.backing = child.Content(content)
+		case "base_clause":
+			typ.extends = namedContents(child, content)
+		case "class_interface_clause":
+			typ.implements = namedContents(child, content)
+		}
+	}
+
+	body := node.ChildByFieldName("body")
+	if body == nil {
+		return typ
+	}
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		for _, member := range extractMember(body.NamedChild(i), content) {
+			languages.AddChild(typ, member)
+		}
+	}
+	return typ
+}
+
+// extractMember extracts the symbols of a class, interface, trait or enum member
+func extractMember(node *sitter.Node, content []byte) []languages.Symbol {
+	switch node.Type() {
+	case "method_declaration":
+		name := languages.FieldContent(node, "name", content)
+		if !strings.EqualFold(name, "__construct") {
+			return []languages.Symbol{extractFunction(node, content, "method")}
+		}
+		// Promoted constructor parameters declare properties
+		symbols := []languages.Symbol{extractFunction(node, content, "constructor")}
+		return append(symbols, extractPromotedProperties(node, content)...)
+	case "property_declaration":
+		return extractProperties(node, content)
+	case "const_declaration":
+		return extractConstants(node, content)
+	case "enum_case":
+		return []languages.Symbol{&Constant{
+			name:    languages.FieldContent(node, "name", content),
+			keyword: "case",
+			doc:     extractDoc(node, content),
+			loc:     languages.NodeRange(node),
+		}}
+	}
+	return nil
+}
+
+// extractFunction extracts a function or method declaration
+func extractFunction(node *sitter.Node, content []byte, kind string) *Function {
+	return &Function{
+		name:       languages.FieldContent(node, "name", content),
+		kind:       kind,
+		modifiers:  extractModifiers(node, content),
+		params:     languages.Compact(languages.FieldContent(node, "parameters", content)),
+		returnType: languages.Compact(languages.FieldContent(node, "return_type", content)),
+		doc:        extractDoc(node, content),
+		loc:        languages.NodeRange(node),
+	}
+}
+
+// extractProperties extracts each property of a property declaration
+// ("public $a, $b;" declares two)
+func extractProperties(node *sitter.Node, content []byte) []languages.Symbol {
+	modifiers := extractModifiers(node, content)
+	typeStr := languages.Compact(languages.FieldContent(node, "type", content))
+	doc := extractDoc(node, content)
+
+	var symbols []languages.Symbol
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() != "property_element" {
+			continue
+		}
+		for j := 0; j < int(child.NamedChildCount()); j++ {
+			if v := child.NamedChild(j); v.Type() == "variable_name" {
+				symbols = append(symbols, &Property{
+					name:      strings.TrimPrefix(v.Content(content), "$"),
+					modifiers: modifiers,
+					typeStr:   typeStr,
+					doc:       doc,
+					loc:       languages.NodeRange(node),
+				})
+				break
+			}
+		}
+	}
+	return symbols
+}
+
+// extractPromotedProperties extracts the properties declared by constructor
+// parameters with a visibility (e.g., "private readonly int $port")
+func extractPromotedProperties(ctor *sitter.Node, content []byte) []languages.Symbol {
+	params := ctor.ChildByFieldName("parameters")
+	if params == nil {
+		return nil
+	}
+	var symbols []languages.Symbol
+	for i := 0; i < int(params.NamedChildCount()); i++ {
+		param := params.NamedChild(i)
+		if param.Type() != "property_promotion_parameter" {
+			continue
+		}
+		var modifiers []string
+		for _, field := range []string{"visibility", "readonly"} {
+			if mod := languages.FieldContent(param, field, content); mod != "" {
+				modifiers = append(modifiers, mod)
+			}
+		}
+		symbols = append(symbols, &Property{
+			name:      strings.TrimPrefix(languages.FieldContent(param, "name", content), "$"),
+			modifiers: modifiers,
+			typeStr:   languages.Compact(languages.FieldContent(param, "type", content)),
+			loc:       languages.NodeRange(param),
+		})
+	}
+	return symbols
+}
+
+// extractConstants extracts each constant of a const declaration
+func extractConstants(node *sitter.Node, content []byte) []languages.Symbol {
+	modifiers := extractModifiers(node, content)
+	doc := extractDoc(node, content)
+
+	var symbols []languages.Symbol
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		if child.Type() != "const_element" || child.NamedChildCount() == 0 {
+			continue
+		}
+		symbols = append(symbols, &Constant{
+			name:      child.NamedChild(0).Content(content),
+			keyword:   "const",
+			modifiers: modifiers,
+			doc:       doc,
+			loc:       languages.NodeRange(node),
+		})
+	}
+	return symbols
+}
+
+// extractDefine extracts a global constant declared with define('NAME', value)
+func extractDefine(stmt *sitter.Node, content []byte) *Constant {
+	if stmt.NamedChildCount() == 0 {
+		return nil
+	}
+	call := stmt.NamedChild(0)
+	if call.Type() != "function_call_expression" || languages.FieldContent(call, "function", content) != "define" {
+		return nil
+	}
+	args := call.ChildByFieldName("arguments")
+	if args == nil || args.NamedChildCount() == 0 {
+		return nil
+	}
+	arg := args.NamedChild(0)
+	if arg.NamedChildCount() == 0 {
+		return nil
+	}
+	str := arg.NamedChild(0)
+	if str.Type() != "string" || str.NamedChildCount() != 1 || str.NamedChild(0).Type() != "string_content" {
+		return nil
+	}
+	return &Constant{
+		name:    str.NamedChild(0).Content(content),
+		keyword: "define",
+		doc:     extractDoc(stmt, content),
+		loc:     languages.NodeRange(stmt),
+	}
+}
+
+// extractModifiers extracts attributes and modifier keywords in source order
+func extractModifiers(node *sitter.Node, content []byte) []string {
+	var modifiers []string
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "visibility_modifier", "static_modifier", "abstract_modifier", "final_modifier",
+			"readonly_modifier", "var_modifier":
+			modifiers = append(modifiers, child.Content(content))
+		case "attribute_list":
+			var names []string
+			for j := 0; j < int(child.NamedChildCount()); j++ {
+				group := child.NamedChild(j)
+				for k := 0; k < int(group.NamedChildCount()); k++ {
+					if attr := group.NamedChild(k); attr.Type() == "attribute" && attr.NamedChildCount() > 0 {
+						names = append(names, attr.NamedChild(0).Content(content))
+					}
+				}
+			}
+			if len(names) > 0 {
+				modifiers = append(modifiers, "#["+strings.Join(names, ", ")+"]")
+			}
+		}
+	}
+
+	return modifiers
+}
+
+// namedContents returns the content of each named child of a node
+// (e.g., the interfaces of an implements clause)
+func namedContents(node *sitter.Node, content []byte) []string {
+	var contents []string
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		contents = append(contents, node.NamedChild(i).Content(content))
+	}
+	return contents
+}
+
+// extractDoc extracts the summary (first line) of the PHPDoc comment
+// (/** ... */) directly preceding a declaration
+func extractDoc(node *sitter.Node, content []byte) string {
+	prev := node.PrevNamedSibling()
+	if prev == nil || prev.Type() != "comment" || node.StartPoint().Row-prev.EndPoint().Row > 1 {
+		return ""
+	}
+	text := prev.Content(content)
+	if !strings.HasPrefix(text, "/**") {
+		return ""
+	}
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "/**"), "*/")
+
+	for line := range strings.SplitSeq(text, "\n") {
+		line = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*"))
+		if line == "" {
+			continue
+		}
+		// The summary ends at the first tag
+		if strings.HasPrefix(line, "@") || strings.HasPrefix(line, "{@") {
+			return ""
+		}
+		return line
+	}
+
+	return ""
+}
@@ -0,0 +1,278 @@
+package php
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+	"github.com/roveo/topo-mcp/languages/languagetest"
+)
+
+func TestLanguageMetadata(t *testing.T) {
+	lang := &Language{}
+
+	if lang.Name() != "php" {
+		t.Errorf("expected name 'php', got %q", lang.Name())
+	}
+
+	exts := lang.Extensions()
+	if len(exts) != 1 || exts[0] != ".php" {
+		t.Errorf("expected extensions [.php], got %v", exts)
+	}
+}
+
+func TestParseUses(t *testing.T) {
+	src := `<?php
+use App\Models\User;
+use Psr\Log\{LoggerInterface, NullLogger as Fallback};
+use function App\helpers\format;
+use \Carbon\Carbon as Date;
+`
+	lang := &Language{}
+	imports, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	expected := []string{`App\Models\User`, `Psr\Log\LoggerInterface`, `Psr\Log\NullLogger`, `App\helpers\format`, `Carbon\Carbon`}
+	if strings.Join(imports, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected imports %v, got %v", expected, imports)
+	}
+	if len(symbols) != 0 {
+		t.Errorf("expected no symbols, got %d", len(symbols))
+	}
+}
+
+func TestParseClass(t *testing.T) {
+	src := `<?php
+namespace App\Services;
+
+/**
+ * Handles incoming requests.
+ *
+ * @package App
+ */
+#[Attr]
+final class Server extends Base implements Runnable, Countable
+{
+    use Loggable;
+
+    public const DEFAULT_PORT = 80;
+    private int $port;
+    protected static ?array $cache = null, $other;
+
+    public function __construct(private readonly int $backlog = 10) {}
+
+    /** Start serving. */
+    public static function start(int $port, string ...$args): bool
+    {
+        return true;
+    }
+
+    abstract protected function handle(Request &$req): void;
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 symbol, got %d", len(symbols))
+	}
+	ns, ok := symbols[0].(*Namespace)
+	if !ok {
+		t.Fatalf("expected *Namespace, got %T", symbols[0])
+	}
+	if ns.String() != `namespace App\Services` {
+		t.Errorf("unexpected String(): %q", ns.String())
+	}
+	if loc := ns.Location(); loc.Start.Line != 1 || loc.End.Line != 26 {
+		t.Errorf("expected namespace on lines 1-26, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+
+	cls := ns.Children()[0].(*Type)
+	want := "#[Attr] final class Server extends Base implements Runnable, Countable"
+	if cls.String() != want {
+		t.Errorf("unexpected String():\ngot:  %q\nwant: %q", cls.String(), want)
+	}
+	if cls.DocComment() != "Handles incoming requests." {
+		t.Errorf("expected doc comment 'Handles incoming requests.', got %q", cls.DocComment())
+	}
+
+	expected := []string{
+		"public const DEFAULT_PORT",
+		"private int $port",
+		"protected static ?array $cache",
+		"protected static ?array $other",
+		"public function __construct(private readonly int $backlog = 10)",
+		"private readonly int $backlog",
+		"public static function start(int $port, string ...$args): bool",
+		"abstract protected function handle(Request &$req): void",
+	}
+	got := languagetest.ChildStrings(cls)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected members:\ngot:  %q\nwant: %q", got, expected)
+	}
+
+	kinds := []string{"constant", "property", "property", "property", "constructor", "property", "method", "method"}
+	for i, m := range cls.Children() {
+		if m.Kind() != kinds[i] {
+			t.Errorf("member %q: expected kind %q, got %q", m.Name(), kinds[i], m.Kind())
+		}
+	}
+
+	start := cls.Children()[6].(*Function)
+	if start.DocComment() != "Start serving." {
+		t.Errorf("expected doc comment 'Start serving.', got %q", start.DocComment())
+	}
+	if loc := start.Location(); loc.Start.Line != 20 || loc.End.Line != 23 {
+		t.Errorf("expected start on lines 20-23, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+}
+
+func TestParseInterfaceTraitEnum(t *testing.T) {
+	src := `<?php
+interface Runnable extends A, B
+{
+    function run();
+}
+
+trait Loggable
+{
+    public function log($message) {}
+}
+
+/** Card suits */
+enum Suit: string implements HasLabel
+{
+    case Hearts = 'H';
+    case Spades = 'S';
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	tests := []struct {
+		kind    string
+		str     string
+		members []string
+	}{
+		{"interface", "interface Runnable extends A, B", []string{"function run()"}},
+		{"trait", "trait Loggable", []string{"public function log($message)"}},
+		{"enum", "enum Suit: string implements HasLabel", []string{"case Hearts", "case Spades"}},
+	}
+
+	if len(symbols) != len(tests) {
+		t.Fatalf("expected %d symbols, got %d", len(tests), len(symbols))
+	}
+	for i, tt := range tests {
+		typ := symbols[i].(*Type)
+		if typ.Kind() != tt.kind {
+			t.Errorf("%s: expected kind %q, got %q", typ.Name(), tt.kind, typ.Kind())
+		}
+		if typ.String() != tt.str {
+			t.Errorf("unexpected String(): got %q, want %q", typ.String(), tt.str)
+		}
+		if got := languagetest.ChildStrings(typ); strings.Join(got, "\n") != strings.Join(tt.members, "\n") {
+			t.Errorf("%s: unexpected members %q, want %q", typ.Name(), got, tt.members)
+		}
+	}
+
+	if doc := symbols[2].(*Type).DocComment(); doc != "Card suits" {
+		t.Errorf("expected doc comment 'Card suits', got %q", doc)
+	}
+}
+
+func TestParsePlugin(t *testing.T) {
+	src := `<?php
+/**
+ * Plugin Name: My Plugin
+ */
+
+define('MY_PLUGIN_DIR', __DIR__);
+const MY_PLUGIN_VERSION = '1.0';
+
+add_action('init', 'my_plugin_init');
+
+/**
+ * Registers the plugin's post types.
+ */
+function my_plugin_init() {
+    register_post_type('book');
+}
+
+if (!function_exists('my_plugin_helper')) {
+    function my_plugin_helper($value = null) {}
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	var got []string
+	for _, sym := range symbols {
+		got = append(got, sym.String())
+	}
+	expected := []string{
+		"define('MY_PLUGIN_DIR')",
+		"const MY_PLUGIN_VERSION",
+		"function my_plugin_init()",
+		"function my_plugin_helper($value = null)",
+	}
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected symbols:\ngot:  %q\nwant: %q", got, expected)
+	}
+
+	if doc := symbols[2].(*Function).DocComment(); doc != "Registers the plugin's post types." {
+		t.Errorf("unexpected doc comment: %q", doc)
+	}
+}
+
+func TestSelectors(t *testing.T) {
+	src := `<?php
+namespace App;
+
+class Server
+{
+    const PORT = 80;
+    private $name;
+    public function start() {}
+}
+
+function helper() {}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	tests := []struct {
+		selector string
+		want     string
+	}{
+		{`App\Server`, "class Server"},
+		{`App\Server::start`, "public function start()"},
+		{"Server::start", "public function start()"},
+		{"Server::PORT", "const PORT"},
+		{"Server::$name", "private $name"},
+		{`App\helper`, "function helper()"},
+	}
+	for _, tt := range tests {
+		found := languages.Lookup(symbols, tt.selector)
+		if len(found) != 1 {
+			t.Errorf("%s: expected 1 match, got %d", tt.selector, len(found))
+			continue
+		}
+		if found[0].String() != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.selector, tt.want, found[0].String())
+		}
+	}
+}
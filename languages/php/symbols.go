@@ -0,0 +1,207 @@
+package php
+
+import (
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Namespace represents a block or file-scoped namespace. Its declarations are its children.
+type Namespace struct {
+	languages.Nesting
+	name string // e.g., "App\Services"
+	loc  languages.Range
+}
+
+func (n *Namespace) Name() string              { return n.name }
+func (n *Namespace) Kind() string              { return "namespace" }
+func (n *Namespace) Location() languages.Range { return n.loc }
+func (n *Namespace) String() string            { return "namespace " + n.name }
+
+// Type represents a class, interface, trait or enum. Members are its children.
+type Type struct {
+	languages.Nesting
+	name       string
+	keyword    string   // "class", "interface", "trait" or "enum"
+	modifiers  []string // Attributes and modifier keywords (e.g., "#[Entity]", "final")
+	backing    string   // Backing type of an enum (e.g., "string")
+	extends    []string
+	implements []string
+	doc        string
+	loc        languages.Range
+}
+
+func (t *Type) Name() string              { return t.name }
+func (t *Type) Kind() string              { return t.keyword }
+func (t *Type) Location() languages.Range { return t.loc }
+func (t *Type) String() string {
+	var sb strings.Builder
+	writeModifiers(&sb, t.modifiers)
+	sb.WriteString(t.keyword)
+	sb.WriteString(" ")
+	sb.WriteString(t.name)
+	if t.backing != "" {
+		sb.WriteString(": ")
+		sb.WriteString(t.backing)
+	}
+	if len(t.extends) > 0 {
+		sb.WriteString(" extends ")
+		sb.WriteString(strings.Join(t.extends, ", "))
+	}
+	if len(t.implements) > 0 {
+		sb.WriteString(" implements ")
+		sb.WriteString(strings.Join(t.implements, ", "))
+	}
+	return sb.String()
+}
+func (t *Type) DocComment() string { return t.doc }
+
+// Selectors returns the fully qualified name (e.g., "App\Services\Server")
+func (t *Type) Selectors() []string {
+	if fqn := qualify(t, t.name); fqn != t.name {
+		return []string{fqn}
+	}
+	return nil
+}
+
+// Function represents a function, method or constructor
+type Function struct {
+	languages.Nesting
+	name       string
+	kind       string // "func", "method" or "constructor"
+	modifiers  []string
+	params     string // e.g., "(int $port, string ...$args)"
+	returnType string // e.g., "bool"
+	doc        string
+	loc        languages.Range
+}
+
+func (f *Function) Name() string              { return f.name }
+func (f *Function) Kind() string              { return f.kind }
+func (f *Function) Location() languages.Range { return f.loc }
+func (f *Function) String() string {
+	var sb strings.Builder
+	writeModifiers(&sb, f.modifiers)
+	sb.WriteString("function ")
+	sb.WriteString(f.name)
+	sb.WriteString(f.params)
+	if f.returnType != "" {
+		sb.WriteString(": ")
+		sb.WriteString(f.returnType)
+	}
+	return sb.String()
+}
+func (f *Function) DocComment() string { return f.doc }
+
+// Selectors returns "Server::start" style selectors for methods and the
+// fully qualified name for namespaced functions
+func (f *Function) Selectors() []string {
+	if f.kind == "func" {
+		if fqn := qualify(f, f.name); fqn != f.name {
+			return []string{fqn}
+		}
+		return nil
+	}
+	return memberSelectors(f, f.name)
+}
+
+// Property represents a property, including one promoted from a constructor parameter
+type Property struct {
+	languages.Nesting
+	name      string // Without the "$"
+	modifiers []string
+	typeStr   string
+	doc       string
+	loc       languages.Range
+}
+
+func (p *Property) Name() string              { return p.name }
+func (p *Property) Kind() string              { return "property" }
+func (p *Property) Location() languages.Range { return p.loc }
+func (p *Property) String() string {
+	var sb strings.Builder
+	writeModifiers(&sb, p.modifiers)
+	if p.typeStr != "" {
+		sb.WriteString(p.typeStr)
+		sb.WriteString(" ")
+	}
+	sb.WriteString("$")
+	sb.WriteString(p.name)
+	return sb.String()
+}
+func (p *Property) DocComment() string { return p.doc }
+func (p *Property) Selectors() []string {
+	return memberSelectors(p, "$"+p.name)
+}
+
+// Constant represents a class constant, an enum case, or a global constant
+// declared with "const" or define()
+type Constant struct {
+	languages.Nesting
+	name      string
+	keyword   string // "const", "case" or "define"
+	modifiers []string
+	doc       string
+	loc       languages.Range
+}
+
+func (c *Constant) Name() string              { return c.name }
+func (c *Constant) Kind() string              { return "constant" }
+func (c *Constant) Location() languages.Range { return c.loc }
+func (c *Constant) String() string {
+	if c.keyword == "define" {
+		return "define('" + c.name + "')"
+	}
+	var sb strings.Builder
+	writeModifiers(&sb, c.modifiers)
+	sb.WriteString(c.keyword)
+	sb.WriteString(" ")
+	sb.WriteString(c.name)
+	return sb.String()
+}
+func (c *Constant) DocComment() string { return c.doc }
+func (c *Constant) Selectors() []string {
+	if _, ok := languages.ParentOf(c).(*Type); ok {
+		return memberSelectors(c, c.name)
+	}
+	// define() always declares a global constant
+	if c.keyword == "define" {
+		return nil
+	}
+	if fqn := qualify(c, c.name); fqn != c.name {
+		return []string{fqn}
+	}
+	return nil
+}
+
+// qualify prefixes a name with the namespace enclosing a symbol, if any
+func qualify(sym languages.Symbol, name string) string {
+	for p := languages.ParentOf(sym); p != nil; p = languages.ParentOf(p) {
+		if ns, ok := p.(*Namespace); ok {
+			return ns.name + `\` + name
+		}
+	}
+	return name
+}
+
+// memberSelectors returns the "Type::member" selectors of a class member,
+// fully qualified first (e.g., "App\Server::start", "Server::start")
+func memberSelectors(sym languages.Symbol, member string) []string {
+	typ, ok := languages.ParentOf(sym).(*Type)
+	if !ok {
+		return nil
+	}
+	short := typ.name + "::" + member
+	if fqn := qualify(typ, typ.name); fqn != typ.name {
+		return []string{fqn + "::" + member, short}
+	}
+	return []string{short}
+}
+
+// writeModifiers writes attributes and modifier keywords followed by a space
+func writeModifiers(sb *strings.Builder, modifiers []string) {
+	for _, mod := range modifiers {
+		sb.WriteString(mod)
+		sb.WriteString(" ")
+	}
+}
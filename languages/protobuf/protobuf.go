@@ -0,0 +1,263 @@
+package protobuf
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/protobuf"
+)
+
+func init() {
+	languages.Register(&Language{})
+}
+
+// proto2Syntax matches a proto2 syntax declaration, up to the closing quote
+var proto2Syntax = regexp.MustCompile(`^\s*syntax\s*=\s*["']proto2["']`)
+
+// Language implements the Protocol Buffers language parser
+type Language struct{}
+
+func (l *Language) Name() string         { return "protobuf" }
+func (l *Language) Extensions() []string { return []string{".proto"} }
+
+func (l *Language) TreeSitterLang() *sitter.Language {
+	return protobuf.GetLanguage()
+}
+
+func (l *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(protobuf.GetLanguage())
+
+	// The grammar only accepts proto3 files. proto2 files are mostly the same
+	// syntax, so they are parsed as proto3 (the replacement keeps all offsets).
+	source := content
+	if syntax := proto2Syntax.FindIndex(content); syntax != nil {
+		source = slices.Clone(content)
+		copy(source[syntax[1]-2:], "3")
+	}
+
+	tree, err := parser.ParseCtx(context.Background(), nil, source)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Protobuf file: %w", err)
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	var imports []string
+	var symbols []languages.Symbol
+
+	// Types are qualified by the package, wherever it is declared
+	e := &extractor{content: content}
+	if pkg := languages.FirstChildOfType(root, "package"); pkg != nil {
+		if id := languages.FirstChildOfType(pkg, "full_ident"); id != nil {
+			e.pkg = id.Content(content)
+		}
+	}
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		child := root.NamedChild(i)
+		switch child.Type() {
+		case "package":
+			if e.pkg != "" {
+				symbols = append(symbols, &Package{
+					name: e.pkg,
+					loc:  languages.NodeRange(child),
+				})
+			}
+		case "import":
+			if path := child.ChildByFieldName("path"); path != nil {
+				imports = append(imports, strings.Trim(path.Content(content), `"'`))
+			}
+		default:
+			if sym := e.extractDefinition(child); sym != nil {
+				symbols = append(symbols, sym)
+			}
+		}
+	}
+
+	return imports, symbols, nil
+}
+
+// extractor extracts the definitions of a file in its package
+type extractor struct {
+	content []byte
+	pkg     string
+}
+
+// extractDefinition extracts a message, enum or service, or returns nil
+func (e *extractor) extractDefinition(node *sitter.Node) languages.Symbol {
+	switch node.Type() {
+	case "message":
+		msg := &Message{
+			name: e.name(node, "message_name"),
+			pkg:  e.pkg,
+			doc:  extractDoc(node, e.content),
+			loc:  languages.NodeRange(node),
+		}
+		if body := languages.FirstChildOfType(node, "message_body"); body != nil {
+			e.addMembers(msg, body)
+		}
+		return msg
+	case "enum":
+		enum := &Enum{
+			name: e.name(node, "enum_name"),
+			pkg:  e.pkg,
+			doc:  extractDoc(node, e.content),
+			loc:  languages.NodeRange(node),
+		}
+		body := languages.FirstChildOfType(node, "enum_body")
+		if body == nil {
+			return enum
+		}
+		for i := 0; i < int(body.NamedChildCount()); i++ {
+			field := body.NamedChild(i)
+			if field.Type() != "enum_field" {
+				continue
+			}
+			value := &EnumValue{
+				name: e.name(field, ""),
+				doc:  extractDoc(field, e.content),
+				loc:  languages.NodeRange(field),
+			}
+			if number := languages.FirstChildOfType(field, "int_lit"); number != nil {
+				value.number = number.Content(e.content)
+				// Negative values keep their sign, which precedes the literal
+				if prev := number.PrevSibling(); prev != nil && prev.Type() == "-" {
+					value.number = "-" + value.number
+				}
+			}
+			languages.AddChild(enum, value)
+		}
+		return enum
+	case "service":
+		svc := &Service{
+			name: e.name(node, "service_name"),
+			pkg:  e.pkg,
+			doc:  extractDoc(node, e.content),
+			loc:  languages.NodeRange(node),
+		}
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			rpc := node.NamedChild(i)
+			if rpc.Type() != "rpc" {
+				continue
+			}
+			languages.AddChild(svc, &RPC{
+				name:      e.name(rpc, "rpc_name"),
+				signature: signature(rpc, e.content, "{", ";"),
+				doc:       extractDoc(rpc, e.content),
+				loc:       languages.NodeRange(rpc),
+			})
+		}
+		return svc
+	}
+	return nil
+}
+
+// addMembers adds the fields, oneofs and nested types of a message body to parent
+func (e *extractor) addMembers(parent languages.Symbol, body *sitter.Node) {
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		child := body.NamedChild(i)
+		switch child.Type() {
+		case "field", "map_field", "oneof_field":
+			languages.AddChild(parent, &Field{
+				name:      e.fieldName(child),
+				signature: signature(child, e.content, "[", ";"),
+				doc:       extractDoc(child, e.content),
+				loc:       languages.NodeRange(child),
+			})
+		case "oneof":
+			oneof := &Oneof{
+				name: e.name(child, ""),
+				doc:  extractDoc(child, e.content),
+				loc:  languages.NodeRange(child),
+			}
+			e.addMembers(oneof, child)
+			languages.AddChild(parent, oneof)
+		default:
+			if sym := e.extractDefinition(child); sym != nil {
+				languages.AddChild(parent, sym)
+			}
+		}
+	}
+}
+
+// name returns the identifier naming a node, which is wrapped in a child of
+// the given type (e.g., "message_name") or, if wrapper is "", a direct child
+func (e *extractor) name(node *sitter.Node, wrapper string) string {
+	if wrapper != "" {
+		if node = languages.FirstChildOfType(node, wrapper); node == nil {
+			return ""
+		}
+	}
+	if id := languages.FirstChildOfType(node, "identifier"); id != nil {
+		return id.Content(e.content)
+	}
+	return ""
+}
+
+// fieldName returns the name of a field: the last identifier before its "=".
+// proto2's "required" label is unknown to the grammar, which then parses the
+// label as the type, the type as an identifier and the name as an error.
+func (e *extractor) fieldName(node *sitter.Node) string {
+	name := ""
+	for i := 0; i < int(node.ChildCount()); i++ {
+		switch child := node.Child(i); child.Type() {
+		case "=":
+			return name
+		case "identifier", "ERROR":
+			name = languages.Compact(child.Content(e.content))
+		}
+	}
+	return name
+}
+
+// signature returns the declaration as written, up to the first of the stop
+// tokens (e.g., options or the terminating ";"), on one line
+func signature(node *sitter.Node, content []byte, stop ...string) string {
+	end := node.EndByte()
+	for i := 0; i < int(node.ChildCount()); i++ {
+		if child := node.Child(i); !child.IsNamed() && slices.Contains(stop, child.Type()) {
+			end = child.StartByte()
+			break
+		}
+	}
+	return languages.Compact(string(content[node.StartByte():end]))
+}
+
+// extractDoc extracts the first line of the leading comments (a run of //
+// lines or a /* */ block) directly preceding a declaration. Comments trailing
+// the previous declaration on its line are not part of the run.
+func extractDoc(node *sitter.Node, content []byte) string {
+	var lines []string
+	expectedRow := node.StartPoint().Row
+	for prev := node.PrevNamedSibling(); prev != nil && prev.Type() == "comment"; prev = prev.PrevNamedSibling() {
+		if prev.EndPoint().Row+1 != expectedRow {
+			break
+		}
+		if before := prev.PrevSibling(); before != nil && before.EndPoint().Row == prev.StartPoint().Row {
+			break
+		}
+		text := prev.Content(content)
+		if strings.HasPrefix(text, "/*") {
+			text = strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+		} else {
+			text = strings.TrimPrefix(text, "//")
+		}
+		lines = append(strings.Split(text, "\n"), lines...)
+		expectedRow = prev.StartPoint().Row
+	}
+
+	for _, line := range lines {
+		if line = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*")); line != "" {
+			return line
+		}
+	}
+	return ""
+}
@@ -0,0 +1,227 @@
+package protobuf
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+	"github.com/roveo/topo-mcp/languages/languagetest"
+)
+
+func TestLanguageMetadata(t *testing.T) {
+	lang := &Language{}
+
+	if lang.Name() != "protobuf" {
+		t.Errorf("expected name 'protobuf', got %q", lang.Name())
+	}
+
+	exts := lang.Extensions()
+	if len(exts) != 1 || exts[0] != ".proto" {
+		t.Errorf("expected extensions [.proto], got %v", exts)
+	}
+}
+
+func TestParsePackageAndImports(t *testing.T) {
+	src := `syntax = "proto3";
+
+package acme.billing.v1;
+
+import "google/protobuf/timestamp.proto";
+import public "acme/common.proto";
+
+option go_package = "acme/billing";
+`
+	lang := &Language{}
+	imports, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	expected := []string{"google/protobuf/timestamp.proto", "acme/common.proto"}
+	if strings.Join(imports, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected imports %v, got %v", expected, imports)
+	}
+	if len(symbols) != 1 || symbols[0].String() != "package acme.billing.v1" {
+		t.Errorf("expected package symbol, got %v", symbols)
+	}
+}
+
+func TestParseMessage(t *testing.T) {
+	src := `syntax = "proto3";
+
+package acme.billing.v1;
+
+// An invoice issued to a customer.
+// Immutable once finalized.
+message Invoice {
+  string id = 1; // Trailing comments are not docs
+  // Line items, in display order
+  repeated LineItem items = 2;
+  map<string, string> labels = 3;
+  oneof payer {
+    string user_id = 5;
+    string org_id = 6;
+  }
+  reserved 7, 8;
+
+  message LineItem {
+    string sku = 1;
+    int64 amount = 2 [deprecated = true];
+  }
+
+  enum Status {
+    STATUS_UNSPECIFIED = 0;
+    STATUS_VOID = -1;
+  }
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 2 {
+		t.Fatalf("expected 2 symbols, got %d", len(symbols))
+	}
+	msg, ok := symbols[1].(*Message)
+	if !ok {
+		t.Fatalf("expected *Message, got %T", symbols[1])
+	}
+	if msg.String() != "message Invoice" || msg.DocComment() != "An invoice issued to a customer." {
+		t.Errorf("unexpected String() %q or doc comment %q", msg.String(), msg.DocComment())
+	}
+	if loc := msg.Location(); loc.Start.Line != 6 || loc.End.Line != 26 {
+		t.Errorf("expected message on lines 6-26, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+
+	expected := []string{
+		"string id = 1",
+		"repeated LineItem items = 2",
+		"map<string, string> labels = 3",
+		"oneof payer",
+		"message LineItem",
+		"enum Status",
+	}
+	got := languagetest.ChildStrings(msg)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected members:\ngot:  %q\nwant: %q", got, expected)
+	}
+
+	members := msg.Children()
+	if doc := members[0].(*Field).DocComment(); doc != "" {
+		t.Errorf("expected no doc comment for id, got %q", doc)
+	}
+	if doc := members[1].(*Field).DocComment(); doc != "Line items, in display order" {
+		t.Errorf("expected doc comment 'Line items, in display order', got %q", doc)
+	}
+
+	nested := []struct {
+		selector string
+		members  []string
+	}{
+		{"Invoice.payer", []string{"string user_id = 5", "string org_id = 6"}},
+		{"Invoice.LineItem", []string{"string sku = 1", "int64 amount = 2"}},
+		{"Invoice.Status", []string{"STATUS_UNSPECIFIED = 0", "STATUS_VOID = -1"}},
+	}
+	for _, tt := range nested {
+		found := languages.Lookup(symbols, tt.selector)
+		if len(found) != 1 {
+			t.Errorf("expected to find %s, got %d matches", tt.selector, len(found))
+			continue
+		}
+		if got := languagetest.ChildStrings(found[0]); strings.Join(got, "\n") != strings.Join(tt.members, "\n") {
+			t.Errorf("%s: unexpected members %q, want %q", tt.selector, got, tt.members)
+		}
+	}
+
+	// Every definition is also addressable by its fully qualified name, which is its ID
+	for _, selector := range []string{
+		"acme.billing.v1.Invoice.LineItem",
+		"acme.billing.v1.Invoice.LineItem.sku",
+		"acme.billing.v1.Invoice.payer.org_id",
+		"acme.billing.v1.Invoice.Status.STATUS_VOID",
+	} {
+		found := languages.Lookup(symbols, selector)
+		if len(found) != 1 {
+			t.Errorf("expected to find %s, got %d matches", selector, len(found))
+			continue
+		}
+		if sel := languages.Selectors(found[0]); sel[0] != selector {
+			t.Errorf("expected %s to be the first selector, got %v", selector, sel)
+		}
+	}
+}
+
+func TestParseService(t *testing.T) {
+	src := `syntax = "proto3";
+
+/* Invoice operations */
+service InvoiceService {
+  // Fetch an invoice.
+  rpc GetInvoice(GetInvoiceRequest) returns (Invoice);
+  rpc Watch(stream WatchRequest) returns (stream Invoice) {
+    option (google.api.http) = { get: "/v1/watch" };
+  }
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 symbol, got %d", len(symbols))
+	}
+	svc, ok := symbols[0].(*Service)
+	if !ok {
+		t.Fatalf("expected *Service, got %T", symbols[0])
+	}
+	if svc.DocComment() != "Invoice operations" {
+		t.Errorf("expected doc comment 'Invoice operations', got %q", svc.DocComment())
+	}
+
+	expected := []string{
+		"rpc GetInvoice(GetInvoiceRequest) returns (Invoice)",
+		"rpc Watch(stream WatchRequest) returns (stream Invoice)",
+	}
+	got := languagetest.ChildStrings(svc)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected rpcs:\ngot:  %q\nwant: %q", got, expected)
+	}
+
+	rpc := svc.Children()[0].(*RPC)
+	if rpc.Kind() != "rpc" || rpc.DocComment() != "Fetch an invoice." {
+		t.Errorf("unexpected kind %q or doc comment %q", rpc.Kind(), rpc.DocComment())
+	}
+	if loc := svc.Children()[1].Location(); loc.Start.Line != 6 || loc.End.Line != 8 {
+		t.Errorf("expected Watch on lines 6-8, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+}
+
+func TestParseProto2(t *testing.T) {
+	src := `syntax = "proto2";
+
+message User {
+  optional string name = 1;
+  required int64 id = 2;
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 symbol, got %d", len(symbols))
+	}
+	var names []string
+	for _, field := range symbols[0].(*Message).Children() {
+		names = append(names, field.Name())
+	}
+	if strings.Join(names, ",") != "name,id" {
+		t.Errorf("expected fields [name id], got %v", names)
+	}
+}
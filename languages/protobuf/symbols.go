@@ -0,0 +1,153 @@
+package protobuf
+
+import (
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Package represents the package declaration
+type Package struct {
+	languages.Nesting
+	name string
+	loc  languages.Range
+}
+
+func (p *Package) Name() string              { return p.name }
+func (p *Package) Kind() string              { return "package" }
+func (p *Package) Location() languages.Range { return p.loc }
+func (p *Package) String() string            { return "package " + p.name }
+
+// Message represents a message. Its fields, oneofs and nested types are its children.
+type Message struct {
+	languages.Nesting
+	name string
+	pkg  string // Package the message is declared in, if any
+	doc  string
+	loc  languages.Range
+}
+
+func (m *Message) Name() string              { return m.name }
+func (m *Message) Kind() string              { return "message" }
+func (m *Message) Location() languages.Range { return m.loc }
+func (m *Message) String() string            { return "message " + m.name }
+func (m *Message) DocComment() string        { return m.doc }
+func (m *Message) Selectors() []string       { return fullName(m) }
+
+// Field represents a message field (e.g., "repeated LineItem items = 2")
+type Field struct {
+	languages.Nesting
+	name      string
+	signature string // Label, type, name and number, without options
+	doc       string
+	loc       languages.Range
+}
+
+func (f *Field) Name() string              { return f.name }
+func (f *Field) Kind() string              { return "field" }
+func (f *Field) Location() languages.Range { return f.loc }
+func (f *Field) String() string            { return f.signature }
+func (f *Field) DocComment() string        { return f.doc }
+func (f *Field) Selectors() []string       { return fullName(f) }
+
+// Oneof represents a oneof group. Its fields are its children.
+type Oneof struct {
+	languages.Nesting
+	name string
+	doc  string
+	loc  languages.Range
+}
+
+func (o *Oneof) Name() string              { return o.name }
+func (o *Oneof) Kind() string              { return "oneof" }
+func (o *Oneof) Location() languages.Range { return o.loc }
+func (o *Oneof) String() string            { return "oneof " + o.name }
+func (o *Oneof) DocComment() string        { return o.doc }
+func (o *Oneof) Selectors() []string       { return fullName(o) }
+
+// Enum represents an enum. Its values are its children.
+type Enum struct {
+	languages.Nesting
+	name string
+	pkg  string
+	doc  string
+	loc  languages.Range
+}
+
+func (e *Enum) Name() string              { return e.name }
+func (e *Enum) Kind() string              { return "enum" }
+func (e *Enum) Location() languages.Range { return e.loc }
+func (e *Enum) String() string            { return "enum " + e.name }
+func (e *Enum) DocComment() string        { return e.doc }
+func (e *Enum) Selectors() []string       { return fullName(e) }
+
+// EnumValue represents an enum value (e.g., "STATUS_PAID = 1")
+type EnumValue struct {
+	languages.Nesting
+	name   string
+	number string
+	doc    string
+	loc    languages.Range
+}
+
+func (v *EnumValue) Name() string              { return v.name }
+func (v *EnumValue) Kind() string              { return "constant" }
+func (v *EnumValue) Location() languages.Range { return v.loc }
+func (v *EnumValue) String() string            { return v.name + " = " + v.number }
+func (v *EnumValue) DocComment() string        { return v.doc }
+func (v *EnumValue) Selectors() []string       { return fullName(v) }
+
+// Service represents a service. Its rpc methods are its children.
+type Service struct {
+	languages.Nesting
+	name string
+	pkg  string
+	doc  string
+	loc  languages.Range
+}
+
+func (s *Service) Name() string              { return s.name }
+func (s *Service) Kind() string              { return "service" }
+func (s *Service) Location() languages.Range { return s.loc }
+func (s *Service) String() string            { return "service " + s.name }
+func (s *Service) DocComment() string        { return s.doc }
+func (s *Service) Selectors() []string       { return fullName(s) }
+
+// RPC represents an rpc method of a service
+type RPC struct {
+	languages.Nesting
+	name      string
+	signature string // e.g., "rpc Watch(stream WatchRequest) returns (stream Invoice)"
+	doc       string
+	loc       languages.Range
+}
+
+func (r *RPC) Name() string              { return r.name }
+func (r *RPC) Kind() string              { return "rpc" }
+func (r *RPC) Location() languages.Range { return r.loc }
+func (r *RPC) String() string            { return r.signature }
+func (r *RPC) DocComment() string        { return r.doc }
+func (r *RPC) Selectors() []string       { return fullName(r) }
+
+// fullName returns the package-qualified name of a definition
+// (e.g., "acme.billing.v1.Invoice.LineItem", "acme.billing.v1.Invoice.id"),
+// or nothing outside a package
+func fullName(sym languages.Symbol) []string {
+	root := sym
+	for p := languages.ParentOf(root); p != nil; p = languages.ParentOf(p) {
+		root = p
+	}
+	var pkg string
+	switch r := root.(type) {
+	case *Message:
+		pkg = r.pkg
+	case *Enum:
+		pkg = r.pkg
+	case *Service:
+		pkg = r.pkg
+	}
+	if pkg == "" {
+		return nil
+	}
+	return []string{pkg + "." + strings.Join(languages.Path(sym), ".")}
+}
@@ -25,6 +25,10 @@ func (p *Language) Extensions() []string {
 	return []string{".py"}
 }
 
+func (p *Language) Interpreters() []string {
+	return []string{"python"}
+}
+
 func (p *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
 	parser := sitter.NewParser()
 	defer parser.Close()
@@ -145,12 +149,46 @@ func extractClass(node *sitter.Node, content []byte) languages.Symbol {
 
 	doc := extractDocstring(node, content)
 
-	return &Class{
+	cls := &Class{
 		name:  name,
 		bases: bases,
 		doc:   doc,
 		loc:   languages.NodeRange(node),
 	}
+
+	body := node.ChildByFieldName("body")
+	if body != nil {
+		for _, member := range extractClassMembers(body, content) {
+			languages.AddChild(cls, member)
+		}
+	}
+
+	return cls
+}
+
+// extractClassMembers extracts methods and nested classes from a class body
+func extractClassMembers(body *sitter.Node, content []byte) []languages.Symbol {
+	var members []languages.Symbol
+
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		child := body.NamedChild(i)
+		switch child.Type() {
+		case "function_definition":
+			members = append(members, extractFunction(child, content))
+		case "class_definition":
+			members = append(members, extractClass(child, content))
+		case "decorated_definition":
+			members = append(members, extractDecorated(child, content)...)
+		}
+	}
+
+	for _, sym := range members {
+		if fn, ok := sym.(*Function); ok {
+			fn.isMethod = true
+		}
+	}
+
+	return members
 }
 
 func extractDecorated(node *sitter.Node, content []byte) []languages.Symbol {
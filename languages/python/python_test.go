@@ -352,3 +352,108 @@ func TestParseClassWithMultipleBases(t *testing.T) {
 		t.Errorf("expected String() to contain 'Base2', got %q", str)
 	}
 }
+
+func TestParseClassMembers(t *testing.T) {
+	src := `class Server:
+    """HTTP server."""
+
+    def handle_request(self, req):
+        pass
+
+    @staticmethod
+    def create() -> "Server":
+        pass
+
+    @classmethod
+    def from_config(cls, cfg):
+        pass
+
+    @property
+    def port(self) -> int:
+        return self._port
+
+    @port.setter
+    def port(self, value):
+        self._port = value
+
+    class Handler:
+        def run(self):
+            pass
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 top-level symbol, got %d", len(symbols))
+	}
+
+	cls, ok := symbols[0].(*Class)
+	if !ok {
+		t.Fatalf("expected *Class, got %T", symbols[0])
+	}
+
+	members := cls.Children()
+	expected := []struct {
+		name string
+		kind string
+	}{
+		{"handle_request", "method"},
+		{"create", "staticmethod"},
+		{"from_config", "classmethod"},
+		{"port", "property"},
+		{"port", "property"},
+		{"Handler", "class"},
+	}
+	if len(members) != len(expected) {
+		t.Fatalf("expected %d members, got %d", len(expected), len(members))
+	}
+	for i, exp := range expected {
+		if members[i].Name() != exp.name {
+			t.Errorf("member[%d]: expected name %q, got %q", i, exp.name, members[i].Name())
+		}
+		if members[i].Kind() != exp.kind {
+			t.Errorf("member[%d]: expected kind %q, got %q", i, exp.kind, members[i].Kind())
+		}
+	}
+
+	if str := members[1].String(); str != `@staticmethod def create() -> "Server"` {
+		t.Errorf("unexpected String() for static method: %q", str)
+	}
+
+	// Nested class has its own methods
+	nested, ok := members[5].(*Class)
+	if !ok {
+		t.Fatalf("expected nested *Class, got %T", members[5])
+	}
+	if len(nested.Children()) != 1 || nested.Children()[0].Name() != "run" {
+		t.Errorf("expected nested class to contain method 'run', got %v", nested.Children())
+	}
+
+	// Method ranges are their own, not the class's
+	loc := members[0].Location()
+	if loc.Start.Line != 3 || loc.End.Line != 4 {
+		t.Errorf("expected handle_request at lines 3-4, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+}
+
+func TestParseTopLevelFunctionIsNotMethod(t *testing.T) {
+	src := `@property
+def helper():
+    pass
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 symbol, got %d", len(symbols))
+	}
+	if symbols[0].Kind() != "func" {
+		t.Errorf("expected kind 'func', got %q", symbols[0].Kind())
+	}
+}
@@ -6,17 +6,32 @@ import (
 	"github.com/roveo/topo-mcp/languages"
 )
 
-// Function represents a Python function definition
+// Function represents a Python function or method definition
 type Function struct {
+	languages.Nesting
 	name       string
 	signature  string
 	decorators []string
+	isMethod   bool // Defined in a class body
 	doc        string
 	loc        languages.Range
 }
 
-func (f *Function) Name() string              { return f.name }
-func (f *Function) Kind() string              { return "func" }
+func (f *Function) Name() string { return f.name }
+func (f *Function) Kind() string {
+	if !f.isMethod {
+		return "func"
+	}
+	for _, dec := range f.decorators {
+		switch {
+		case dec == "staticmethod", dec == "classmethod", dec == "property":
+			return dec
+		case strings.HasSuffix(dec, ".setter"), strings.HasSuffix(dec, ".deleter"):
+			return "property"
+		}
+	}
+	return "method"
+}
 func (f *Function) Location() languages.Range { return f.loc }
 func (f *Function) String() string {
 	var sb strings.Builder
@@ -32,8 +47,10 @@ func (f *Function) String() string {
 }
 func (f *Function) DocComment() string { return f.doc }
 
-// Class represents a Python class definition
+// Class represents a Python class definition.
+// Methods and nested classes are its children.
 type Class struct {
+	languages.Nesting
 	name       string
 	bases      []string
 	decorators []string
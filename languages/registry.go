@@ -1,7 +1,13 @@
 package languages
 
 import (
+	"bytes"
+	"fmt"
+	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
 	"strings"
 
 	sitter "github.com/smacker/go-tree-sitter"
@@ -9,20 +15,233 @@ import (
 
 var registry = make(map[string]Language)
 
-// Register adds a language to the registry
+// filenames maps exact file names to languages (see NamedFiles)
+var filenames = make(map[string]Language)
+
+// globs holds the patterns of languages implementing Patterns, in registration order
+var globs []pattern
+
+// interpreters maps shebang interpreter names to languages (see Interpreted)
+var interpreters = make(map[string]Language)
+
+// byName maps language names to the first language registered with each name
+var byName = make(map[string]Language)
+
+// overrides holds user-defined patterns (see Override), checked before any other rule
+var overrides []pattern
+
+// conflicts records claims made by more than one language (see Conflicts)
+var conflicts []Conflict
+
+// pattern is a glob pattern matched against file base names
+type pattern struct {
+	glob string
+	lang Language
+}
+
+// Conflict records a file extension, name, pattern or interpreter claimed by
+// more than one language. The language registered first keeps the claim.
+type Conflict struct {
+	Claim   string // What is claimed (e.g., `extension ".h"`, `interpreter "node"`)
+	Kept    string // Name of the language that handles the claim
+	Ignored string // Name of the language whose claim is ignored
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("%s is claimed by both %s and %s; using %s", c.Claim, c.Kept, c.Ignored, c.Kept)
+}
+
+// Register adds a language to the registry. Claims that another language has
+// already made are ignored and recorded as conflicts.
 func Register(lang Language) {
+	if _, ok := byName[lang.Name()]; !ok {
+		byName[lang.Name()] = lang
+	}
 	for _, ext := range lang.Extensions() {
-		registry[ext] = lang
+		claim(registry, "extension", ext, lang)
+	}
+	if named, ok := lang.(NamedFiles); ok {
+		for _, name := range named.Filenames() {
+			claim(filenames, "file name", name, lang)
+		}
+	}
+	if patterns, ok := lang.(Patterns); ok {
+		for _, glob := range patterns.Globs() {
+			i := slices.IndexFunc(globs, func(p pattern) bool { return p.glob == glob })
+			switch {
+			case i == -1:
+				globs = append(globs, pattern{glob: glob, lang: lang})
+			case globs[i].lang != lang:
+				conflicts = append(conflicts, Conflict{Claim: fmt.Sprintf("pattern %q", glob), Kept: globs[i].lang.Name(), Ignored: lang.Name()})
+			}
+		}
 	}
+	if interp, ok := lang.(Interpreted); ok {
+		for _, name := range interp.Interpreters() {
+			claim(interpreters, "interpreter", name, lang)
+		}
+	}
+}
+
+// claim maps key to lang in table, unless another language has claimed it
+func claim(table map[string]Language, kind, key string, lang Language) {
+	if existing, ok := table[key]; ok && existing != lang {
+		conflicts = append(conflicts, Conflict{Claim: fmt.Sprintf("%s %q", kind, key), Kept: existing.Name(), Ignored: lang.Name()})
+		return
+	}
+	table[key] = lang
+}
+
+// Conflicts returns the claims made by more than one language, in registration order
+func Conflicts() []Conflict {
+	return slices.Clone(conflicts)
+}
+
+// Override makes files whose base name matches a glob pattern use the named
+// language (e.g., "*.tpl" -> "go"), ahead of every other rule. Earlier
+// overrides win over later ones.
+func Override(glob, langName string) error {
+	if _, err := filepath.Match(glob, ""); err != nil {
+		return fmt.Errorf("invalid pattern %q: %w", glob, err)
+	}
+	lang, ok := byName[langName]
+	if !ok {
+		names := RegisteredLanguages()
+		sort.Strings(names)
+		return fmt.Errorf("unknown language %q (available: %s)", langName, strings.Join(names, ", "))
+	}
+	overrides = append(overrides, pattern{glob: glob, lang: lang})
+	return nil
 }
 
-// GetLanguageForFile returns the Language for a file based on its extension.
-// Returns nil if the file type is not supported.
+// GetLanguageForFile returns the Language for a file. User overrides come
+// first, then exact file names, glob patterns (the longest match wins) and
+// extensions. Files without an extension are recognized by their shebang line
+// or an editor modeline. Returns nil if the file type is not supported.
 func GetLanguageForFile(path string) Language {
+	base := filepath.Base(path)
+	for _, o := range overrides {
+		if ok, _ := filepath.Match(o.glob, base); ok {
+			return o.lang
+		}
+	}
+	if lang, ok := filenames[base]; ok {
+		return lang
+	}
+	var match *pattern
+	for i, p := range globs {
+		if ok, _ := filepath.Match(p.glob, base); ok && (match == nil || len(p.glob) > len(match.glob)) {
+			match = &globs[i]
+		}
+	}
+	if match != nil {
+		return match.lang
+	}
 	ext := strings.ToLower(filepath.Ext(path))
+	if ext == "" {
+		return sniffLanguage(readHead(path))
+	}
 	return registry[ext]
 }
 
+// sniffLanguage returns the language named by the shebang line or a modeline
+// at the start of content, or nil
+func sniffLanguage(content []byte) Language {
+	if lang := interpreterLanguage(ShebangInterpreter(content)); lang != nil {
+		return lang
+	}
+	filetype := ModelineFiletype(content)
+	if lang, ok := byName[filetype]; ok {
+		return lang
+	}
+	return interpreterLanguage(filetype)
+}
+
+// interpreterLanguage returns the language for an interpreter name, ignoring
+// its version ("python3.12" -> "python"), or nil
+func interpreterLanguage(name string) Language {
+	if name == "" {
+		return nil
+	}
+	if lang, ok := interpreters[name]; ok {
+		return lang
+	}
+	if lang, ok := interpreters[strings.TrimRight(name, "0123456789.")]; ok {
+		return lang
+	}
+	return nil
+}
+
+// readHead returns the first bytes of a file, enough to hold a shebang line
+// and modelines, or nil if it can't be read
+func readHead(path string) []byte {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	buf := make([]byte, 256)
+	n, _ := f.Read(buf)
+	return buf[:n]
+}
+
+// ShebangInterpreter returns the name of the interpreter in the shebang line
+// at the start of content, or "" if there is none. Interpreters run through
+// env are resolved ("#!/usr/bin/env -S bash -e" -> "bash").
+func ShebangInterpreter(content []byte) string {
+	if !bytes.HasPrefix(content, []byte("#!")) {
+		return ""
+	}
+	line, _, _ := bytes.Cut(content[2:], []byte("\n"))
+	fields := strings.Fields(string(line))
+	if len(fields) == 0 {
+		return ""
+	}
+
+	interpreter := filepath.Base(fields[0])
+	if interpreter != "env" {
+		return interpreter
+	}
+	// Skip env's options and variable assignments
+	for _, field := range fields[1:] {
+		if !strings.HasPrefix(field, "-") && !strings.Contains(field, "=") {
+			return filepath.Base(field)
+		}
+	}
+	return ""
+}
+
+// vimModeline matches a vim modeline setting the file type (e.g., "vim: set ft=python:")
+var vimModeline = regexp.MustCompile(`(?:^|\s)(?:vi|vim|ex):.*?\b(?:ft|filetype|syntax)=([\w+-]+)`)
+
+// emacsModeline matches the variables of an Emacs modeline (e.g., "-*- mode: ruby -*-")
+var emacsModeline = regexp.MustCompile(`-\*-(.+?)-\*-`)
+
+// ModelineFiletype returns the file type named by a vim or Emacs modeline in
+// content, lowercased, or "" if there is none
+func ModelineFiletype(content []byte) string {
+	for line := range strings.SplitSeq(string(content), "\n") {
+		if m := vimModeline.FindStringSubmatch(line); m != nil {
+			return strings.ToLower(m[1])
+		}
+		m := emacsModeline.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		// Either a bare mode ("-*- python -*-") or variables ("-*- mode: ruby; coding: utf-8 -*-")
+		if !strings.Contains(m[1], ":") {
+			return strings.ToLower(strings.TrimSpace(m[1]))
+		}
+		for variable := range strings.SplitSeq(m[1], ";") {
+			if name, value, ok := strings.Cut(variable, ":"); ok && strings.TrimSpace(name) == "mode" {
+				return strings.ToLower(strings.TrimSpace(value))
+			}
+		}
+	}
+	return ""
+}
+
 // SupportedExtensions returns all registered file extensions
 func SupportedExtensions() []string {
 	exts := make([]string, 0, len(registry))
@@ -32,15 +251,12 @@ func SupportedExtensions() []string {
 	return exts
 }
 
-// RegisteredLanguages returns the names of all registered languages
+// RegisteredLanguages returns the names of all registered languages,
+// including those that only handle file names, patterns or interpreters
 func RegisteredLanguages() []string {
-	seen := make(map[string]bool)
-	var names []string
-	for _, lang := range registry {
-		if !seen[lang.Name()] {
-			seen[lang.Name()] = true
-			names = append(names, lang.Name())
-		}
+	names := make([]string, 0, len(byName))
+	for name := range byName {
+		names = append(names, name)
 	}
 	return names
 }
@@ -48,13 +264,8 @@ func RegisteredLanguages() []string {
 // GetTreeSitterLanguage returns the tree-sitter language for a registered language name.
 // Returns nil if the language doesn't exist or doesn't implement TreeSitterLanguage.
 func GetTreeSitterLanguage(name string) *sitter.Language {
-	for _, lang := range registry {
-		if lang.Name() == name {
-			if tsLang, ok := lang.(TreeSitterLanguage); ok {
-				return tsLang.TreeSitterLang()
-			}
-			return nil
-		}
+	if tsLang, ok := byName[name].(TreeSitterLanguage); ok {
+		return tsLang.TreeSitterLang()
 	}
 	return nil
 }
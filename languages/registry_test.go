@@ -1,6 +1,9 @@
 package languages
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -17,10 +20,7 @@ func (m *mockLanguage) Parse(content []byte) ([]string, []Symbol, error) {
 }
 
 func TestRegister(t *testing.T) {
-	// Save original registry
-	origRegistry := registry
-	registry = make(map[string]Language)
-	defer func() { registry = origRegistry }()
+	resetRegistry(t)
 
 	lang := &mockLanguage{name: "test", exts: []string{".test", ".tst"}}
 	Register(lang)
@@ -35,10 +35,7 @@ func TestRegister(t *testing.T) {
 }
 
 func TestGetLanguageForFile(t *testing.T) {
-	// Save original registry
-	origRegistry := registry
-	registry = make(map[string]Language)
-	defer func() { registry = origRegistry }()
+	resetRegistry(t)
 
 	lang := &mockLanguage{name: "test", exts: []string{".test"}}
 	Register(lang)
@@ -64,11 +61,294 @@ func TestGetLanguageForFile(t *testing.T) {
 	}
 }
 
+// mockNamedLanguage is a test implementation of a Language matched by file name
+type mockNamedLanguage struct {
+	mockLanguage
+	names []string
+}
+
+func (m *mockNamedLanguage) Filenames() []string { return m.names }
+
+func TestGetLanguageForFile_Filenames(t *testing.T) {
+	// Save original registries
+	origRegistry, origFilenames := registry, filenames
+	registry, filenames = make(map[string]Language), make(map[string]Language)
+	defer func() { registry, filenames = origRegistry, origFilenames }()
+
+	lang := &mockNamedLanguage{mockLanguage: mockLanguage{name: "test", exts: []string{".test"}}, names: []string{"Testfile"}}
+	Register(lang)
+
+	tests := []struct {
+		path     string
+		wantLang Language
+	}{
+		{"Testfile", lang},
+		{"path/to/Testfile", lang},
+		{"file.test", lang},
+		{"testfile", nil}, // File names are case sensitive
+		{"Testfile.bak", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got := GetLanguageForFile(tt.path)
+			if got != tt.wantLang {
+				t.Errorf("GetLanguageForFile(%q) = %v, want %v", tt.path, got, tt.wantLang)
+			}
+		})
+	}
+}
+
+// mockScriptLanguage is a test implementation of a Language recognized by shebang
+type mockScriptLanguage struct {
+	mockLanguage
+	interpreters []string
+}
+
+func (m *mockScriptLanguage) Interpreters() []string { return m.interpreters }
+
+func TestShebangInterpreter(t *testing.T) {
+	tests := []struct {
+		content string
+		want    string
+	}{
+		{"#!/bin/sh\necho hi\n", "sh"},
+		{"#!/bin/bash -e\n", "bash"},
+		{"#! /usr/bin/env bash\n", "bash"},
+		{"#!/usr/bin/env -S bash -eu\n", "bash"},
+		{"#!/usr/bin/env LANG=C python3\n", "python3"},
+		{"#!/usr/bin/env\n", ""},
+		{"echo hi\n", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := ShebangInterpreter([]byte(tt.content)); got != tt.want {
+			t.Errorf("ShebangInterpreter(%q) = %q, want %q", tt.content, got, tt.want)
+		}
+	}
+}
+
+func TestGetLanguageForFile_Shebang(t *testing.T) {
+	// Save original registries
+	origRegistry, origInterpreters := registry, interpreters
+	registry, interpreters = make(map[string]Language), make(map[string]Language)
+	defer func() { registry, interpreters = origRegistry, origInterpreters }()
+
+	lang := &mockScriptLanguage{mockLanguage: mockLanguage{name: "script", exts: []string{".script"}}, interpreters: []string{"script"}}
+	Register(lang)
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"deploy":      "#!/usr/bin/env script\nrun\n",
+		"other":       "#!/bin/other\nrun\n",
+		"plain":       "run\n",
+		"notes.txt":   "#!/usr/bin/env script\n",
+		"task.script": "run\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		wantLang Language
+	}{
+		{"deploy", lang},
+		{"other", nil},
+		{"plain", nil},
+		{"notes.txt", nil}, // Only extensionless files are sniffed
+		{"task.script", lang},
+		{"missing", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetLanguageForFile(filepath.Join(dir, tt.name))
+			if got != tt.wantLang {
+				t.Errorf("GetLanguageForFile(%q) = %v, want %v", tt.name, got, tt.wantLang)
+			}
+		})
+	}
+}
+
+// mockPatternLanguage is a test implementation of a Language matched by glob patterns
+type mockPatternLanguage struct {
+	mockLanguage
+	globs []string
+}
+
+func (m *mockPatternLanguage) Globs() []string { return m.globs }
+
+// resetRegistry empties every registry for the duration of a test
+func resetRegistry(t *testing.T) {
+	t.Helper()
+	origRegistry, origFilenames, origGlobs, origInterpreters := registry, filenames, globs, interpreters
+	origByName, origOverrides, origConflicts := byName, overrides, conflicts
+	registry, filenames, globs, interpreters = make(map[string]Language), make(map[string]Language), nil, make(map[string]Language)
+	byName, overrides, conflicts = make(map[string]Language), nil, nil
+	t.Cleanup(func() {
+		registry, filenames, globs, interpreters = origRegistry, origFilenames, origGlobs, origInterpreters
+		byName, overrides, conflicts = origByName, origOverrides, origConflicts
+	})
+}
+
+func TestGetLanguageForFile_Globs(t *testing.T) {
+	resetRegistry(t)
+
+	tpl := &mockLanguage{name: "tpl", exts: []string{".tpl"}}
+	gotpl := &mockPatternLanguage{mockLanguage: mockLanguage{name: "gotpl"}, globs: []string{"*.go.tpl"}}
+	docker := &mockPatternLanguage{mockLanguage: mockLanguage{name: "docker"}, globs: []string{"Dockerfile.*", "*.*.*"}}
+	Register(tpl)
+	Register(gotpl)
+	Register(docker)
+
+	tests := []struct {
+		path     string
+		wantLang Language
+	}{
+		{"src/page.tpl", tpl},
+		{"src/main.go.tpl", gotpl}, // Patterns win over extensions, and the longest match wins
+		{"Dockerfile.dev", docker},
+		{"Dockerfile", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got := GetLanguageForFile(tt.path)
+			if got != tt.wantLang {
+				t.Errorf("GetLanguageForFile(%q) = %v, want %v", tt.path, got, tt.wantLang)
+			}
+		})
+	}
+}
+
+func TestOverride(t *testing.T) {
+	resetRegistry(t)
+
+	golang := &mockLanguage{name: "go", exts: []string{".go"}}
+	named := &mockNamedLanguage{mockLanguage: mockLanguage{name: "make"}, names: []string{"Makefile"}}
+	Register(golang)
+	Register(named)
+
+	if err := Override("*.tpl", "go"); err != nil {
+		t.Fatalf("Override failed: %v", err)
+	}
+	if err := Override("Makefile", "go"); err != nil {
+		t.Fatalf("Override failed: %v", err)
+	}
+	if err := Override("*.tpl", "missing"); err == nil || !strings.Contains(err.Error(), "unknown language") {
+		t.Errorf("expected unknown language error, got %v", err)
+	}
+	if err := Override("[", "go"); err == nil {
+		t.Error("expected error for invalid pattern")
+	}
+
+	if got := GetLanguageForFile("templates/page.tpl"); got != golang {
+		t.Errorf("expected *.tpl to be parsed as go, got %v", got)
+	}
+	// Overrides take precedence over file names
+	if got := GetLanguageForFile("Makefile"); got != golang {
+		t.Errorf("expected Makefile to be parsed as go, got %v", got)
+	}
+}
+
+func TestModelineFiletype(t *testing.T) {
+	tests := []struct {
+		content string
+		want    string
+	}{
+		{"# vim: set ft=python:\n", "python"},
+		{"// vim:filetype=Ruby\n", "ruby"},
+		{"# vi: syntax=sh ts=4\n", "sh"},
+		{"# -*- mode: ruby; coding: utf-8 -*-\n", "ruby"},
+		{"# -*- python -*-\n", "python"},
+		{"# -*- coding: utf-8 -*-\nprint(1)\n", ""},
+		{"# ft=python\n", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := ModelineFiletype([]byte(tt.content)); got != tt.want {
+			t.Errorf("ModelineFiletype(%q) = %q, want %q", tt.content, got, tt.want)
+		}
+	}
+}
+
+func TestGetLanguageForFile_Sniffing(t *testing.T) {
+	resetRegistry(t)
+
+	python := &mockScriptLanguage{mockLanguage: mockLanguage{name: "python", exts: []string{".py"}}, interpreters: []string{"python"}}
+	shell := &mockScriptLanguage{mockLanguage: mockLanguage{name: "bash", exts: []string{".sh"}}, interpreters: []string{"bash", "sh"}}
+	Register(python)
+	Register(shell)
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"versioned": "#!/usr/bin/env python3.12\n",
+		"vim":       "# vim: ft=python\nprint(1)\n",
+		"emacs":     "# -*- mode: sh -*-\necho hi\n",
+		"unknown":   "# vim: ft=cobol\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		wantLang Language
+	}{
+		{"versioned", python},
+		{"vim", python},  // By language name
+		{"emacs", shell}, // By interpreter name
+		{"unknown", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetLanguageForFile(filepath.Join(dir, tt.name))
+			if got != tt.wantLang {
+				t.Errorf("GetLanguageForFile(%q) = %v, want %v", tt.name, got, tt.wantLang)
+			}
+		})
+	}
+}
+
+func TestRegister_Conflicts(t *testing.T) {
+	resetRegistry(t)
+
+	c := &mockNamedLanguage{mockLanguage: mockLanguage{name: "c", exts: []string{".c", ".h"}}, names: []string{"Cfile"}}
+	cpp := &mockNamedLanguage{mockLanguage: mockLanguage{name: "cpp", exts: []string{".cpp", ".h"}}, names: []string{"Cfile"}}
+	Register(c)
+	Register(cpp)
+	Register(c) // Registering a language again is not a conflict
+
+	// The first language keeps the claim
+	if got := GetLanguageForFile("util.h"); got != c {
+		t.Errorf("expected .h to stay with c, got %v", got)
+	}
+
+	want := []string{
+		`extension ".h" is claimed by both c and cpp; using c`,
+		`file name "Cfile" is claimed by both c and cpp; using c`,
+	}
+	got := Conflicts()
+	if len(got) != len(want) {
+		t.Fatalf("expected %d conflicts, got %v", len(want), got)
+	}
+	for i, conflict := range got {
+		if conflict.String() != want[i] {
+			t.Errorf("conflict %d: expected %q, got %q", i, want[i], conflict.String())
+		}
+	}
+}
+
 func TestSupportedExtensions(t *testing.T) {
-	// Save original registry
-	origRegistry := registry
-	registry = make(map[string]Language)
-	defer func() { registry = origRegistry }()
+	resetRegistry(t)
 
 	lang1 := &mockLanguage{name: "lang1", exts: []string{".a", ".b"}}
 	lang2 := &mockLanguage{name: "lang2", exts: []string{".c"}}
@@ -93,19 +373,19 @@ func TestSupportedExtensions(t *testing.T) {
 }
 
 func TestRegisteredLanguages(t *testing.T) {
-	// Save original registry
-	origRegistry := registry
-	registry = make(map[string]Language)
-	defer func() { registry = origRegistry }()
+	resetRegistry(t)
 
 	lang1 := &mockLanguage{name: "lang1", exts: []string{".a", ".b"}}
 	lang2 := &mockLanguage{name: "lang2", exts: []string{".c"}}
+	// Languages without extensions are registered too
+	named := &mockNamedLanguage{mockLanguage: mockLanguage{name: "named"}, names: []string{"Buildfile"}}
 	Register(lang1)
 	Register(lang2)
+	Register(named)
 
 	names := RegisteredLanguages()
-	if len(names) != 2 {
-		t.Errorf("expected 2 languages, got %d: %v", len(names), names)
+	if len(names) != 3 {
+		t.Errorf("expected 3 languages, got %d: %v", len(names), names)
 	}
 
 	// Check both names are present
@@ -119,6 +399,9 @@ func TestRegisteredLanguages(t *testing.T) {
 	if !nameMap["lang2"] {
 		t.Error("expected lang2 in registered languages")
 	}
+	if !nameMap["named"] {
+		t.Error("expected named in registered languages")
+	}
 }
 
 func TestNodeRange(t *testing.T) {
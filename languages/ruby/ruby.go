@@ -0,0 +1,359 @@
+package ruby
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/ruby"
+)
+
+func init() {
+	languages.Register(&Language{})
+}
+
+// Language implements the Ruby language parser
+type Language struct{}
+
+func (l *Language) Name() string           { return "ruby" }
+func (l *Language) Extensions() []string   { return []string{".rb", ".rake"} }
+func (l *Language) Filenames() []string    { return []string{"Gemfile", "Rakefile"} }
+func (l *Language) Interpreters() []string { return []string{"ruby"} }
+func (l *Language) TreeSitterLang() *sitter.Language {
+	return ruby.GetLanguage()
+}
+
+func (l *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(ruby.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Ruby file: %w", err)
+	}
+	defer tree.Close()
+
+	e := &extractor{content: content}
+	symbols := e.body(tree.RootNode(), scope{})
+	return e.imports, symbols, nil
+}
+
+// declaringCalls are the DSL calls that declare something named after their
+// first argument (an association, a scope, a Rake task, a gem). Other calls
+// with a symbol argument, such as callbacks ("before_save :normalize") and
+// validations ("validates :total"), refer to existing methods and are not
+// indexed unless they have a block (e.g., "namespace :db do").
+var declaringCalls = map[string]bool{
+	// Rails models
+	"has_many":                true,
+	"has_one":                 true,
+	"belongs_to":              true,
+	"has_and_belongs_to_many": true,
+	"has_one_attached":        true,
+	"has_many_attached":       true,
+	"has_rich_text":           true,
+	"scope":                   true,
+	"enum":                    true,
+	"attribute":               true,
+	"alias_attribute":         true,
+	"delegated_type":          true,
+	"composed_of":             true,
+	// Metaprogramming
+	"define_method": true,
+	"alias_method":  true,
+	// Rakefiles and Gemfiles
+	"task":      true,
+	"multitask": true,
+	"file":      true,
+	"directory": true,
+	"rule":      true,
+	"namespace": true,
+	"gem":       true,
+}
+
+// visibilities are the methods that change the visibility of the following methods
+var visibilities = map[string]bool{
+	"private":   true,
+	"protected": true,
+	"public":    true,
+}
+
+// scope describes the body being extracted
+type scope struct {
+	class     bool // A class or module body, extended by nameless blocks such as "included do"
+	singleton bool // Inside "class << self", where methods are singleton methods
+}
+
+// extractor walks a Ruby syntax tree and collects its imports and symbols
+type extractor struct {
+	content []byte
+	imports []string
+}
+
+// body extracts the declarations of the program, a class or module body, or a DSL block
+func (e *extractor) body(node *sitter.Node, s scope) []languages.Symbol {
+	if node == nil {
+		return nil
+	}
+
+	var symbols []languages.Symbol
+	visibility := ""
+	desc := "" // Rake description for the next task
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "module":
+			mod := &Module{
+				name: e.text(child.ChildByFieldName("name")),
+				doc:  extractDoc(child, e.content),
+				loc:  languages.NodeRange(child),
+			}
+			for _, sym := range e.body(child.ChildByFieldName("body"), scope{class: true}) {
+				languages.AddChild(mod, sym)
+			}
+			symbols = append(symbols, mod)
+		case "class":
+			cls := &Class{
+				name: e.text(child.ChildByFieldName("name")),
+				doc:  extractDoc(child, e.content),
+				loc:  languages.NodeRange(child),
+			}
+			if super := child.ChildByFieldName("superclass"); super != nil && super.NamedChildCount() > 0 {
+				cls.superclass = e.text(super.NamedChild(0))
+			}
+			for _, sym := range e.body(child.ChildByFieldName("body"), scope{class: true}) {
+				languages.AddChild(cls, sym)
+			}
+			symbols = append(symbols, cls)
+		case "singleton_class":
+			// Methods of "class << self" belong to the enclosing class
+			symbols = append(symbols, e.body(child.ChildByFieldName("body"), scope{class: true, singleton: true})...)
+		case "method", "singleton_method":
+			symbols = append(symbols, e.method(child, child, visibility, s.singleton))
+		case "assignment":
+			if left := child.ChildByFieldName("left"); left != nil && left.Type() == "constant" {
+				symbols = append(symbols, &Constant{
+					name: e.text(left),
+					doc:  extractDoc(child, e.content),
+					loc:  languages.NodeRange(child),
+				})
+			}
+		case "identifier":
+			// A bare "private" applies to the methods that follow it
+			if name := e.text(child); visibilities[name] {
+				visibility = strings.TrimPrefix(name, "public")
+			}
+		case "call":
+			if child.ChildByFieldName("receiver") != nil {
+				continue
+			}
+			method := e.text(child.ChildByFieldName("method"))
+			if method == "desc" {
+				desc, _ = e.firstArg(child)
+				continue
+			}
+			syms := e.call(child, method, s)
+			if desc != "" {
+				for _, sym := range syms {
+					if call, ok := sym.(*Call); ok && call.doc == "" {
+						call.doc = desc
+					}
+				}
+				desc = ""
+			}
+			symbols = append(symbols, syms...)
+		}
+	}
+
+	return symbols
+}
+
+// call extracts the symbols declared by a receiverless method call: an import,
+// a method with a visibility modifier, accessors or a DSL call
+func (e *extractor) call(node *sitter.Node, method string, s scope) []languages.Symbol {
+	args := node.ChildByFieldName("arguments")
+
+	switch method {
+	case "require", "require_relative":
+		if name, _ := e.firstArg(node); name != "" {
+			e.imports = append(e.imports, name)
+		}
+		return nil
+	case "attr_accessor", "attr_reader", "attr_writer":
+		return e.attributes(node, node, method, "")
+	}
+
+	if visibilities[method] {
+		// "private def helper" or "private attr_reader :id"
+		if args == nil || args.NamedChildCount() == 0 {
+			return nil
+		}
+		visibility := strings.TrimPrefix(method, "public")
+		arg := args.NamedChild(0)
+		switch arg.Type() {
+		case "method", "singleton_method":
+			return []languages.Symbol{e.method(arg, node, visibility, s.singleton)}
+		case "call":
+			if macro := e.text(arg.ChildByFieldName("method")); strings.HasPrefix(macro, "attr_") {
+				return e.attributes(arg, node, macro, visibility)
+			}
+		}
+		return nil
+	}
+
+	block := node.ChildByFieldName("block")
+	name, arg := e.firstArg(node)
+	if name == "" {
+		// Blocks such as "included do" of a concern extend the class body
+		if s.class && block != nil {
+			return e.body(block.ChildByFieldName("body"), scope{class: true, singleton: method == "class_methods"})
+		}
+		return nil
+	}
+	if block == nil && !declaringCalls[method] {
+		return nil
+	}
+
+	call := &Call{
+		name:   name,
+		method: method,
+		arg:    arg,
+		doc:    extractDoc(node, e.content),
+		loc:    languages.NodeRange(node),
+	}
+	if block != nil {
+		for _, sym := range e.body(block.ChildByFieldName("body"), scope{}) {
+			languages.AddChild(call, sym)
+		}
+	}
+	return []languages.Symbol{call}
+}
+
+// method extracts a method definition. The anchor is the node the method's
+// range and doc comment are taken from (the visibility call of "private def").
+func (e *extractor) method(node, anchor *sitter.Node, visibility string, singleton bool) *Method {
+	m := &Method{
+		name:       e.text(node.ChildByFieldName("name")),
+		visibility: visibility,
+		doc:        extractDoc(anchor, e.content),
+		loc:        languages.NodeRange(anchor),
+	}
+	if node.Type() == "singleton_method" {
+		m.receiver = e.text(node.ChildByFieldName("object"))
+	} else if singleton {
+		m.receiver = "self"
+	}
+	if params := node.ChildByFieldName("parameters"); params != nil {
+		m.params = languages.Compact(e.text(params))
+		if !strings.HasPrefix(m.params, "(") {
+			m.params = "(" + m.params + ")"
+		}
+	}
+	return m
+}
+
+// attributes extracts one accessor per symbol argument of an attr_* call
+func (e *extractor) attributes(node, anchor *sitter.Node, macro, visibility string) []languages.Symbol {
+	args := node.ChildByFieldName("arguments")
+	if args == nil {
+		return nil
+	}
+	doc := extractDoc(anchor, e.content)
+	var symbols []languages.Symbol
+	for i := 0; i < int(args.NamedChildCount()); i++ {
+		arg := args.NamedChild(i)
+		if arg.Type() != "simple_symbol" {
+			continue
+		}
+		symbols = append(symbols, &Attribute{
+			name:       strings.TrimPrefix(e.text(arg), ":"),
+			macro:      macro,
+			visibility: visibility,
+			doc:        doc,
+			loc:        languages.NodeRange(anchor),
+		})
+	}
+	return symbols
+}
+
+// firstArg returns the name given by the first argument of a call and the
+// argument as written. The name is the value of a symbol or a plain string,
+// or the key of a hash argument ("task build: :compile"). Both are empty if
+// the first argument names nothing.
+func (e *extractor) firstArg(node *sitter.Node) (name, arg string) {
+	args := node.ChildByFieldName("arguments")
+	if args == nil || args.NamedChildCount() == 0 {
+		return "", ""
+	}
+	first := args.NamedChild(0)
+	key := first
+	if first.Type() == "pair" {
+		key = first.ChildByFieldName("key")
+	}
+	switch key.Type() {
+	case "simple_symbol":
+		name = strings.TrimPrefix(e.text(key), ":")
+	case "hash_key_symbol":
+		name = e.text(key)
+	case "string":
+		// Interpolated strings don't name anything
+		if key.NamedChildCount() != 1 || key.NamedChild(0).Type() != "string_content" {
+			return "", ""
+		}
+		name = e.text(key.NamedChild(0))
+	default:
+		return "", ""
+	}
+	return name, languages.Compact(e.text(first))
+}
+
+// text returns the source text of a node, or "" for a nil node
+func (e *extractor) text(node *sitter.Node) string {
+	if node == nil {
+		return ""
+	}
+	return node.Content(e.content)
+}
+
+// extractDoc returns the first line of the run of "#" comments directly above a node
+func extractDoc(node *sitter.Node, content []byte) string {
+	prev := node.PrevNamedSibling()
+	if prev == nil || prev.Type() != "comment" || isTrailingComment(prev) {
+		return ""
+	}
+	if node.StartPoint().Row-prev.EndPoint().Row > 1 {
+		return ""
+	}
+
+	// Walk back to the first of a run of line comments
+	first := prev
+	for {
+		before := first.PrevNamedSibling()
+		if before == nil || before.Type() != "comment" || isTrailingComment(before) ||
+			first.StartPoint().Row-before.EndPoint().Row > 1 {
+			break
+		}
+		first = before
+	}
+
+	text := strings.TrimSpace(strings.TrimLeft(first.Content(content), "#"))
+	if strings.HasPrefix(text, "=begin") {
+		// Block comment: use its first line of text
+		lines := strings.Split(first.Content(content), "\n")
+		if len(lines) < 2 {
+			return ""
+		}
+		text = strings.TrimSpace(lines[1])
+	}
+	return text
+}
+
+// isTrailingComment reports whether a comment follows code on the same line
+func isTrailingComment(comment *sitter.Node) bool {
+	before := comment.PrevSibling()
+	return before != nil && before.EndPoint().Row == comment.StartPoint().Row
+}
@@ -0,0 +1,266 @@
+package ruby
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+	"github.com/roveo/topo-mcp/languages/languagetest"
+)
+
+func TestLanguageMetadata(t *testing.T) {
+	lang := &Language{}
+
+	if lang.Name() != "ruby" {
+		t.Errorf("expected name 'ruby', got %q", lang.Name())
+	}
+
+	exts := lang.Extensions()
+	if strings.Join(exts, ",") != ".rb,.rake" {
+		t.Errorf("expected extensions [.rb .rake], got %v", exts)
+	}
+
+	if got := languages.GetLanguageForFile("app/Gemfile"); got == nil || got.Name() != "ruby" {
+		t.Errorf("expected Gemfile to be handled by ruby, got %v", got)
+	}
+}
+
+func TestParseRequires(t *testing.T) {
+	src := `require "json"
+require 'active_support/core_ext'
+require_relative "../lib/base"
+require "lib/#{name}"
+`
+	lang := &Language{}
+	imports, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	expected := []string{"json", "active_support/core_ext", "../lib/base"}
+	if strings.Join(imports, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected imports %v, got %v", expected, imports)
+	}
+	if len(symbols) != 0 {
+		t.Errorf("expected no symbols, got %d", len(symbols))
+	}
+}
+
+func TestParseModel(t *testing.T) {
+	src := `# Billing helpers
+module Billing
+  VERSION = "1.0"
+
+  # A customer invoice
+  class Invoice < ApplicationRecord
+    include Payable
+    attr_accessor :amount, :currency
+    attr_reader :id
+
+    has_many :line_items, dependent: :destroy
+    belongs_to :customer
+    scope :paid, -> { where(paid: true) }
+    before_save :normalize
+    private_class_method :new
+
+    # Build a new invoice
+    def self.build(attrs = {})
+      new(attrs)
+    end
+
+    class << self
+      def find_all; end
+    end
+
+    def total(tax:, discount: 0)
+      amount
+    end
+
+    protected
+
+    def paid?
+      true
+    end
+
+    private def normalize = amount.round
+  end
+end
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 symbol, got %d", len(symbols))
+	}
+
+	mod, ok := symbols[0].(*Module)
+	if !ok {
+		t.Fatalf("expected *Module, got %T", symbols[0])
+	}
+	if mod.String() != "module Billing" || mod.DocComment() != "Billing helpers" {
+		t.Errorf("unexpected module: %q // %q", mod.String(), mod.DocComment())
+	}
+	if got := languagetest.ChildStrings(mod); strings.Join(got, ",") != "VERSION,class Invoice < ApplicationRecord" {
+		t.Fatalf("unexpected module members: %q", got)
+	}
+
+	cls := mod.Children()[1].(*Class)
+	if cls.DocComment() != "A customer invoice" {
+		t.Errorf("expected doc comment 'A customer invoice', got %q", cls.DocComment())
+	}
+
+	expected := []string{
+		"attr_accessor :amount",
+		"attr_accessor :currency",
+		"attr_reader :id",
+		"has_many :line_items",
+		"belongs_to :customer",
+		"scope :paid",
+		"def self.build(attrs = {})",
+		"def self.find_all",
+		"def total(tax:, discount: 0)",
+		"protected def paid?",
+		"private def normalize",
+	}
+	got := languagetest.ChildStrings(cls)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected members:\ngot:  %q\nwant: %q", got, expected)
+	}
+
+	kinds := []string{"attribute", "attribute", "attribute", "has_many", "belongs_to", "scope",
+		"singleton_method", "singleton_method", "method", "method", "method"}
+	for i, m := range cls.Children() {
+		if m.Kind() != kinds[i] {
+			t.Errorf("member %q: expected kind %q, got %q", m.Name(), kinds[i], m.Kind())
+		}
+	}
+
+	build := cls.Children()[6].(*Method)
+	if build.DocComment() != "Build a new invoice" {
+		t.Errorf("expected doc comment 'Build a new invoice', got %q", build.DocComment())
+	}
+	if loc := build.Location(); loc.Start.Line != 17 || loc.End.Line != 19 {
+		t.Errorf("expected build on lines 17-19, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+	if lineItems := cls.Children()[3]; lineItems.Name() != "line_items" {
+		t.Errorf("expected DSL call name 'line_items', got %q", lineItems.Name())
+	}
+}
+
+func TestMethodSelectors(t *testing.T) {
+	src := `module Billing
+  class Invoice
+    has_many :line_items
+    validates :total, presence: true
+    before_save :total
+
+    def self.build; end
+    def total; end
+  end
+end
+
+class Billing::Report
+  def to_s; end
+end
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	tests := []struct {
+		selector string
+		want     string
+	}{
+		{"Billing::Invoice", "class Invoice"},
+		{"Billing::Invoice.build", "def self.build"},
+		{"Billing::Invoice#total", "def total"},
+		{"Billing::Report#to_s", "def to_s"},
+		{"Billing::Invoice::line_items", "has_many :line_items"},
+		{"total", "def total"}, // Validations don't shadow the attribute
+	}
+	for _, tt := range tests {
+		found := languages.Lookup(symbols, tt.selector)
+		if len(found) != 1 {
+			t.Errorf("%s: expected 1 match, got %d", tt.selector, len(found))
+			continue
+		}
+		if found[0].String() != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.selector, tt.want, found[0].String())
+		}
+	}
+}
+
+func TestParseRakefileAndGemfile(t *testing.T) {
+	src := `source "https://rubygems.org"
+gem "rails", "~> 7.0"
+
+group :development do
+  gem "pry"
+end
+
+namespace :db do
+  desc "Run migrations"
+  task migrate: :environment do
+    puts "migrating"
+  end
+end
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	var got []string
+	languages.Walk(symbols, func(sym languages.Symbol, depth int) {
+		got = append(got, strings.Repeat("  ", depth)+sym.String())
+	})
+	expected := []string{
+		`gem "rails"`,
+		"group :development",
+		`  gem "pry"`,
+		"namespace :db",
+		"  task migrate: :environment",
+	}
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected symbols:\ngot:  %q\nwant: %q", got, expected)
+	}
+
+	migrate := languages.Lookup(symbols, "db.migrate")
+	if len(migrate) != 1 {
+		t.Fatalf("expected to find db.migrate, got %d matches", len(migrate))
+	}
+	if doc := migrate[0].(*Call).DocComment(); doc != "Run migrations" {
+		t.Errorf("expected task description as doc comment, got %q", doc)
+	}
+}
+
+func TestParseConcern(t *testing.T) {
+	src := `module Trackable
+  extend ActiveSupport::Concern
+
+  included do
+    has_many :events
+  end
+
+  class_methods do
+    def tracked; end
+  end
+end
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	got := languagetest.ChildStrings(symbols[0])
+	if strings.Join(got, ",") != "has_many :events,def self.tracked" {
+		t.Errorf("unexpected members: %q", got)
+	}
+}
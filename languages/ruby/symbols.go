@@ -0,0 +1,175 @@
+package ruby
+
+import (
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Module represents a module. Its classes, methods and constants are its children.
+type Module struct {
+	languages.Nesting
+	name string // As written, possibly scoped (e.g., "Billing::Helpers")
+	doc  string
+	loc  languages.Range
+}
+
+func (m *Module) Name() string              { return m.name }
+func (m *Module) Kind() string              { return "module" }
+func (m *Module) Location() languages.Range { return m.loc }
+func (m *Module) String() string            { return "module " + m.name }
+func (m *Module) DocComment() string        { return m.doc }
+func (m *Module) Selectors() []string       { return scopedSelectors(m) }
+
+// Class represents a class. Its methods, attributes, constants, DSL calls and
+// nested classes are its children.
+type Class struct {
+	languages.Nesting
+	name       string // As written, possibly scoped (e.g., "Billing::Report")
+	superclass string
+	doc        string
+	loc        languages.Range
+}
+
+func (c *Class) Name() string              { return c.name }
+func (c *Class) Kind() string              { return "class" }
+func (c *Class) Location() languages.Range { return c.loc }
+func (c *Class) String() string {
+	if c.superclass != "" {
+		return "class " + c.name + " < " + c.superclass
+	}
+	return "class " + c.name
+}
+func (c *Class) DocComment() string  { return c.doc }
+func (c *Class) Selectors() []string { return scopedSelectors(c) }
+
+// Method represents an instance method or a singleton method
+// ("def self.build" or a method inside "class << self")
+type Method struct {
+	languages.Nesting
+	name       string
+	receiver   string // "self" or the receiver of a singleton method; empty for instance methods
+	visibility string // "private" or "protected"; empty for public methods
+	params     string // e.g., "(attrs = {})"
+	doc        string
+	loc        languages.Range
+}
+
+func (m *Method) Name() string { return m.name }
+func (m *Method) Kind() string {
+	if m.receiver != "" {
+		return "singleton_method"
+	}
+	return "method"
+}
+func (m *Method) Location() languages.Range { return m.loc }
+func (m *Method) String() string {
+	var sb strings.Builder
+	if m.visibility != "" {
+		sb.WriteString(m.visibility)
+		sb.WriteString(" ")
+	}
+	sb.WriteString("def ")
+	if m.receiver != "" {
+		sb.WriteString(m.receiver)
+		sb.WriteString(".")
+	}
+	sb.WriteString(m.name)
+	sb.WriteString(m.params)
+	return sb.String()
+}
+func (m *Method) DocComment() string { return m.doc }
+
+// Selectors returns the Ruby-style method reference: "Billing::Invoice#total"
+// for instance methods and "Billing::Invoice.build" for singleton methods
+func (m *Method) Selectors() []string {
+	owner := ownerPath(m)
+	if owner == "" {
+		return nil
+	}
+	if m.receiver != "" {
+		return []string{owner + "." + m.name}
+	}
+	return []string{owner + "#" + m.name}
+}
+
+// Attribute represents an accessor generated by attr_accessor, attr_reader or attr_writer
+type Attribute struct {
+	languages.Nesting
+	name       string
+	macro      string // "attr_accessor", "attr_reader" or "attr_writer"
+	visibility string
+	doc        string
+	loc        languages.Range
+}
+
+func (a *Attribute) Name() string              { return a.name }
+func (a *Attribute) Kind() string              { return "attribute" }
+func (a *Attribute) Location() languages.Range { return a.loc }
+func (a *Attribute) String() string {
+	if a.visibility != "" {
+		return a.visibility + " " + a.macro + " :" + a.name
+	}
+	return a.macro + " :" + a.name
+}
+func (a *Attribute) DocComment() string { return a.doc }
+
+// Constant represents a constant assignment
+type Constant struct {
+	languages.Nesting
+	name string
+	doc  string
+	loc  languages.Range
+}
+
+func (c *Constant) Name() string              { return c.name }
+func (c *Constant) Kind() string              { return "constant" }
+func (c *Constant) Location() languages.Range { return c.loc }
+func (c *Constant) String() string            { return c.name }
+func (c *Constant) DocComment() string        { return c.doc }
+func (c *Constant) Selectors() []string       { return scopedSelectors(c) }
+
+// Call represents a DSL call that declares something by name, such as
+// "has_many :line_items" in a model, "task :build" in a Rakefile or
+// "gem \"rails\"" in a Gemfile. Its kind is the called method. Calls with a
+// block (e.g., "namespace :db do") contain the DSL calls of the block.
+type Call struct {
+	languages.Nesting
+	name   string // The first argument (symbol, string or hash key)
+	method string // e.g., "has_many"
+	arg    string // The first argument as written (e.g., ":line_items")
+	doc    string
+	loc    languages.Range
+}
+
+func (c *Call) Name() string              { return c.name }
+func (c *Call) Kind() string              { return c.method }
+func (c *Call) Location() languages.Range { return c.loc }
+func (c *Call) String() string            { return c.method + " " + c.arg }
+func (c *Call) DocComment() string        { return c.doc }
+func (c *Call) Selectors() []string       { return scopedSelectors(c) }
+
+// scopedSelectors returns the "::"-separated path of a module, class,
+// constant or DSL call, or nil for top-level symbols
+func scopedSelectors(sym languages.Symbol) []string {
+	owner := ownerPath(sym)
+	if owner == "" {
+		return nil
+	}
+	return []string{owner + "::" + sym.Name()}
+}
+
+// ownerPath returns the "::"-separated path of the modules and classes
+// enclosing a symbol, or "" if it has none
+func ownerPath(sym languages.Symbol) string {
+	var parts []string
+	for p := languages.ParentOf(sym); p != nil; p = languages.ParentOf(p) {
+		switch p.(type) {
+		case *Module, *Class:
+			parts = append([]string{p.Name()}, parts...)
+		default:
+			return ""
+		}
+	}
+	return strings.Join(parts, "::")
+}
@@ -31,13 +31,17 @@ func (r *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
 	}
 	defer tree.Close()
 
-	root := tree.RootNode()
+	imports, symbols := extractItems(tree.RootNode(), content)
+	return imports, symbols, nil
+}
 
+// extractItems extracts the items of a source file or inline module body
+func extractItems(node *sitter.Node, content []byte) ([]string, []languages.Symbol) {
 	var imports []string
 	var symbols []languages.Symbol
 
-	for i := 0; i < int(root.NamedChildCount()); i++ {
-		child := root.NamedChild(i)
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
 		switch child.Type() {
 		case "use_declaration":
 			imports = append(imports, extractUse(child, content)...)
@@ -50,7 +54,7 @@ func (r *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
 		case "trait_item":
 			symbols = append(symbols, extractTrait(child, content))
 		case "impl_item":
-			symbols = append(symbols, extractImpl(child, content)...)
+			symbols = append(symbols, extractImpl(child, content))
 		case "const_item":
 			symbols = append(symbols, extractConst(child, content))
 		case "static_item":
@@ -58,11 +62,13 @@ func (r *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
 		case "type_item":
 			symbols = append(symbols, extractTypeAlias(child, content))
 		case "mod_item":
-			symbols = append(symbols, extractMod(child, content))
+			mod, modImports := extractMod(child, content)
+			symbols = append(symbols, mod)
+			imports = append(imports, modImports...)
 		}
 	}
 
-	return imports, symbols, nil
+	return imports, symbols
 }
 
 func extractUse(node *sitter.Node, content []byte) []string {
@@ -131,12 +137,52 @@ func extractEnum(node *sitter.Node, content []byte) languages.Symbol {
 	vis := extractVisibility(node, content)
 	doc := extractDoc(node, content)
 
-	return &Enum{
+	enum := &Enum{
 		name:       name,
 		visibility: vis,
 		doc:        doc,
 		loc:        languages.NodeRange(node),
 	}
+
+	body := node.ChildByFieldName("body")
+	if body != nil {
+		for i := 0; i < int(body.NamedChildCount()); i++ {
+			child := body.NamedChild(i)
+			if child.Type() == "enum_variant" {
+				languages.AddChild(enum, extractVariant(child, content))
+			}
+		}
+	}
+
+	return enum
+}
+
+// extractVariant extracts an enum variant with its payload shape
+func extractVariant(node *sitter.Node, content []byte) languages.Symbol {
+	nameNode := node.ChildByFieldName("name")
+	name := ""
+	if nameNode != nil {
+		name = nameNode.Content(content)
+	}
+
+	shape := ""
+	if body := node.ChildByFieldName("body"); body != nil {
+		// Collapse multi-line struct payloads onto one line
+		shape = strings.Join(strings.Fields(body.Content(content)), " ")
+		if body.Type() == "field_declaration_list" {
+			shape = " " + shape
+		}
+	}
+	if value := node.ChildByFieldName("value"); value != nil {
+		shape += " = " + value.Content(content)
+	}
+
+	return &Variant{
+		name:  name,
+		shape: shape,
+		doc:   extractDoc(node, content),
+		loc:   languages.NodeRange(node),
+	}
 }
 
 func extractTrait(node *sitter.Node, content []byte) languages.Symbol {
@@ -149,20 +195,40 @@ func extractTrait(node *sitter.Node, content []byte) languages.Symbol {
 	vis := extractVisibility(node, content)
 	doc := extractDoc(node, content)
 
-	return &Trait{
+	trait := &Trait{
 		name:       name,
 		visibility: vis,
 		doc:        doc,
 		loc:        languages.NodeRange(node),
 	}
-}
 
-func extractImpl(node *sitter.Node, content []byte) []languages.Symbol {
-	var symbols []languages.Symbol
+	body := node.ChildByFieldName("body")
+	if body != nil {
+		for i := 0; i < int(body.NamedChildCount()); i++ {
+			child := body.NamedChild(i)
+			switch child.Type() {
+			case "function_signature_item", "function_item":
+				sym := extractFunction(child, content)
+				if fn, ok := sym.(*Function); ok {
+					fn.inTrait = true
+				}
+				languages.AddChild(trait, sym)
+			case "associated_type":
+				languages.AddChild(trait, extractTypeAlias(child, content))
+			case "const_item":
+				languages.AddChild(trait, extractConst(child, content))
+			}
+		}
+	}
 
+	return trait
+}
+
+func extractImpl(node *sitter.Node, content []byte) languages.Symbol {
 	// Get the type being implemented
 	typeName := ""
 	traitName := ""
+	typeParams := ""
 
 	typeNode := node.ChildByFieldName("type")
 	if typeNode != nil {
@@ -174,23 +240,51 @@ func extractImpl(node *sitter.Node, content []byte) []languages.Symbol {
 		traitName = traitNode.Content(content)
 	}
 
-	// Extract methods from the impl body
+	paramsNode := node.ChildByFieldName("type_parameters")
+	if paramsNode != nil {
+		typeParams = paramsNode.Content(content)
+	}
+
+	impl := &Impl{
+		typeName:   typeName,
+		traitName:  traitName,
+		typeParams: typeParams,
+		doc:        extractDoc(node, content),
+		loc:        languages.NodeRange(node),
+	}
+
+	// Extract methods and associated items from the impl body
 	body := node.ChildByFieldName("body")
 	if body != nil {
 		for i := 0; i < int(body.NamedChildCount()); i++ {
 			child := body.NamedChild(i)
-			if child.Type() == "function_item" {
+			switch child.Type() {
+			case "function_item":
 				sym := extractFunction(child, content)
 				if fn, ok := sym.(*Function); ok {
 					fn.receiver = typeName
 					fn.traitImpl = traitName
 				}
-				symbols = append(symbols, sym)
+				languages.AddChild(impl, sym)
+			case "type_item":
+				sym := extractTypeAlias(child, content)
+				if t, ok := sym.(*TypeAlias); ok {
+					t.receiver = typeName
+					t.traitImpl = traitName
+				}
+				languages.AddChild(impl, sym)
+			case "const_item":
+				sym := extractConst(child, content)
+				if c, ok := sym.(*Const); ok {
+					c.receiver = typeName
+					c.traitImpl = traitName
+				}
+				languages.AddChild(impl, sym)
 			}
 		}
 	}
 
-	return symbols
+	return impl
 }
 
 func extractConst(node *sitter.Node, content []byte) languages.Symbol {
@@ -247,7 +341,9 @@ func extractTypeAlias(node *sitter.Node, content []byte) languages.Symbol {
 	}
 }
 
-func extractMod(node *sitter.Node, content []byte) languages.Symbol {
+// extractMod extracts a module declaration. Items of inline modules become its
+// children; their imports are returned alongside.
+func extractMod(node *sitter.Node, content []byte) (languages.Symbol, []string) {
 	nameNode := node.ChildByFieldName("name")
 	name := ""
 	if nameNode != nil {
@@ -257,12 +353,24 @@ func extractMod(node *sitter.Node, content []byte) languages.Symbol {
 	vis := extractVisibility(node, content)
 	doc := extractDoc(node, content)
 
-	return &Mod{
+	mod := &Mod{
 		name:       name,
 		visibility: vis,
 		doc:        doc,
 		loc:        languages.NodeRange(node),
 	}
+
+	var imports []string
+	body := node.ChildByFieldName("body")
+	if body != nil {
+		var items []languages.Symbol
+		imports, items = extractItems(body, content)
+		for _, item := range items {
+			languages.AddChild(mod, item)
+		}
+	}
+
+	return mod, imports
 }
 
 func formatSignature(params, returnType *sitter.Node, content []byte) string {
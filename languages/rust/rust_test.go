@@ -1,8 +1,11 @@
 package rust
 
 import (
+	"slices"
 	"strings"
 	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
 )
 
 func TestLanguageMetadata(t *testing.T) {
@@ -191,25 +194,32 @@ impl Server {
 		t.Fatalf("Parse failed: %v", err)
 	}
 
-	// Should have: Server (struct), new (method), start (method)
-	if len(symbols) != 3 {
-		t.Fatalf("expected 3 symbols, got %d", len(symbols))
+	// Should have: Server (struct), impl Server containing new and start
+	if len(symbols) != 2 {
+		t.Fatalf("expected 2 symbols, got %d", len(symbols))
 	}
 
-	// Find methods
-	var methods []*Function
-	for _, sym := range symbols {
-		if fn, ok := sym.(*Function); ok && fn.receiver != "" {
-			methods = append(methods, fn)
-		}
+	impl, ok := symbols[1].(*Impl)
+	if !ok {
+		t.Fatalf("expected *Impl, got %T", symbols[1])
+	}
+	if impl.Name() != "Server" {
+		t.Errorf("expected name 'Server', got %q", impl.Name())
+	}
+	if impl.String() != "impl Server" {
+		t.Errorf("expected String() 'impl Server', got %q", impl.String())
 	}
 
+	methods := impl.Children()
 	if len(methods) != 2 {
 		t.Fatalf("expected 2 methods, got %d", len(methods))
 	}
 
 	// Check first method
-	m := methods[0]
+	m, ok := methods[0].(*Function)
+	if !ok {
+		t.Fatalf("expected *Function, got %T", methods[0])
+	}
 	if m.Name() != "new" {
 		t.Errorf("expected name 'new', got %q", m.Name())
 	}
@@ -219,10 +229,13 @@ impl Server {
 	if m.receiver != "Server" {
 		t.Errorf("expected receiver 'Server', got %q", m.receiver)
 	}
+	if m.Parent() != impl {
+		t.Errorf("expected method parent to be the impl block")
+	}
 
 	str := m.String()
-	if !strings.Contains(str, "impl Server") {
-		t.Errorf("expected String() to contain 'impl Server', got %q", str)
+	if str != "pub fn new(port: u16) -> Self" {
+		t.Errorf("expected String() 'pub fn new(port: u16) -> Self', got %q", str)
 	}
 }
 
@@ -241,31 +254,35 @@ impl Handler for MyHandler {
 		t.Fatalf("Parse failed: %v", err)
 	}
 
-	// Should have: MyHandler (struct), handle (method)
+	// Should have: MyHandler (struct), impl Handler for MyHandler
 	if len(symbols) != 2 {
 		t.Fatalf("expected 2 symbols, got %d", len(symbols))
 	}
 
-	// Find the method
-	var method *Function
-	for _, sym := range symbols {
-		if fn, ok := sym.(*Function); ok && fn.receiver != "" {
-			method = fn
-			break
-		}
+	impl, ok := symbols[1].(*Impl)
+	if !ok {
+		t.Fatalf("expected *Impl, got %T", symbols[1])
+	}
+	if impl.String() != "impl Handler for MyHandler" {
+		t.Errorf("expected String() 'impl Handler for MyHandler', got %q", impl.String())
+	}
+	if impl.Trait() != "Handler" {
+		t.Errorf("expected Trait() 'Handler', got %q", impl.Trait())
 	}
 
-	if method == nil {
-		t.Fatal("expected to find a method")
+	if len(impl.Children()) != 1 {
+		t.Fatalf("expected 1 method, got %d", len(impl.Children()))
+	}
+	method, ok := impl.Children()[0].(*Function)
+	if !ok {
+		t.Fatalf("expected *Function, got %T", impl.Children()[0])
 	}
 
 	if method.traitImpl != "Handler" {
 		t.Errorf("expected traitImpl 'Handler', got %q", method.traitImpl)
 	}
-
-	str := method.String()
-	if !strings.Contains(str, "impl Handler for MyHandler") {
-		t.Errorf("expected String() to contain 'impl Handler for MyHandler', got %q", str)
+	if method.Implements() != "Handler.handle" {
+		t.Errorf("expected Implements() 'Handler.handle', got %q", method.Implements())
 	}
 }
 
@@ -528,3 +545,242 @@ func TestParseVisibilityModifiers(t *testing.T) {
 		})
 	}
 }
+
+func TestParseEnumVariants(t *testing.T) {
+	src := `pub enum Shape {
+    /// No shape
+    Empty,
+    Circle(f64),
+    Rect {
+        w: f64,
+        h: f64,
+    },
+    Code = 3,
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 symbol, got %d", len(symbols))
+	}
+
+	e, ok := symbols[0].(*Enum)
+	if !ok {
+		t.Fatalf("expected *Enum, got %T", symbols[0])
+	}
+
+	expected := []string{"Empty", "Circle(f64)", "Rect { w: f64, h: f64, }", "Code = 3"}
+	variants := e.Children()
+	if len(variants) != len(expected) {
+		t.Fatalf("expected %d variants, got %d", len(expected), len(variants))
+	}
+	for i, exp := range expected {
+		if variants[i].String() != exp {
+			t.Errorf("variant[%d]: expected %q, got %q", i, exp, variants[i].String())
+		}
+		if variants[i].Kind() != "variant" {
+			t.Errorf("variant[%d]: expected kind 'variant', got %q", i, variants[i].Kind())
+		}
+	}
+
+	if doc := variants[0].(*Variant).DocComment(); doc != "No shape" {
+		t.Errorf("expected doc 'No shape', got %q", doc)
+	}
+	if loc := variants[2].Location(); loc.Start.Line != 4 || loc.End.Line != 7 {
+		t.Errorf("expected Rect at lines 4-7, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+}
+
+func TestParseTraitItems(t *testing.T) {
+	src := `pub trait Handler {
+    type Output;
+    const MAX: usize = 10;
+    /// Handle a value
+    fn handle(&self, x: i32) -> Self::Output;
+    fn name(&self) -> String { String::new() }
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	trait, ok := symbols[0].(*Trait)
+	if !ok {
+		t.Fatalf("expected *Trait, got %T", symbols[0])
+	}
+
+	expected := []struct {
+		kind string
+		str  string
+	}{
+		{"type", "type Output"},
+		{"const", "const MAX"},
+		{"method", "fn handle(&self, x: i32) -> Self::Output"},
+		{"method", "fn name(&self) -> String"},
+	}
+	items := trait.Children()
+	if len(items) != len(expected) {
+		t.Fatalf("expected %d items, got %d", len(expected), len(items))
+	}
+	for i, exp := range expected {
+		if items[i].Kind() != exp.kind {
+			t.Errorf("item[%d]: expected kind %q, got %q", i, exp.kind, items[i].Kind())
+		}
+		if items[i].String() != exp.str {
+			t.Errorf("item[%d]: expected %q, got %q", i, exp.str, items[i].String())
+		}
+	}
+}
+
+func TestParseImplAssociatedItems(t *testing.T) {
+	src := `impl<T> fmt::Display for Foo<T> {
+    type Output = u8;
+    const MAX: usize = 5;
+    fn fmt(&self, f: &mut fmt::Formatter) -> fmt::Result { Ok(()) }
+}
+
+impl Foo {
+    fn new() -> Self { Foo }
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 2 {
+		t.Fatalf("expected 2 impl blocks, got %d", len(symbols))
+	}
+
+	if symbols[0].String() != "impl<T> fmt::Display for Foo<T>" {
+		t.Errorf("unexpected impl String(): %q", symbols[0].String())
+	}
+	if symbols[0].Name() != "Foo" {
+		t.Errorf("expected impl name 'Foo', got %q", symbols[0].Name())
+	}
+
+	items := append(symbols[0].(*Impl).Children(), symbols[1].(*Impl).Children()...)
+	expected := []struct {
+		str        string
+		implements string
+	}{
+		{"type Output", "Display.Output"},
+		{"const MAX", "Display.MAX"},
+		{"fn fmt(&self, f: &mut fmt::Formatter) -> fmt::Result", "Display.fmt"},
+		{"fn new() -> Self", ""},
+	}
+	if len(items) != len(expected) {
+		t.Fatalf("expected %d items, got %d", len(expected), len(items))
+	}
+	for i, exp := range expected {
+		if items[i].String() != exp.str {
+			t.Errorf("item[%d]: expected %q, got %q", i, exp.str, items[i].String())
+		}
+		impl, ok := items[i].(interface{ Implements() string })
+		if !ok {
+			t.Fatalf("item[%d]: %T does not report the trait item it implements", i, items[i])
+		}
+		if impl.Implements() != exp.implements {
+			t.Errorf("item[%d]: expected Implements() %q, got %q", i, exp.implements, impl.Implements())
+		}
+	}
+}
+
+func TestParseInlineModule(t *testing.T) {
+	src := `pub mod server {
+    use std::net::TcpListener;
+
+    pub struct Server;
+
+    impl Server {
+        pub fn start(&self) {}
+    }
+}
+
+mod external;
+`
+	lang := &Language{}
+	imports, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(imports) != 1 || imports[0] != "std::net::TcpListener" {
+		t.Errorf("expected imports from inline module, got %v", imports)
+	}
+
+	if len(symbols) != 2 {
+		t.Fatalf("expected 2 symbols, got %d", len(symbols))
+	}
+
+	mod, ok := symbols[0].(*Mod)
+	if !ok {
+		t.Fatalf("expected *Mod, got %T", symbols[0])
+	}
+	if len(mod.Children()) != 2 {
+		t.Fatalf("expected 2 items in module, got %d", len(mod.Children()))
+	}
+
+	start := languages.ChildrenOf(mod.Children()[1])[0]
+	if got := languages.QualifiedName(start); got != "server.Server.start" {
+		t.Errorf("expected qualified name 'server.Server.start', got %q", got)
+	}
+
+	if n := len(symbols[1].(*Mod).Children()); n != 0 {
+		t.Errorf("expected no children for external module, got %d", n)
+	}
+}
+
+func TestImplItemSelectors(t *testing.T) {
+	src := `impl<T> fmt::Display for Foo<T> {
+    fn fmt(&self, f: &mut fmt::Formatter) -> fmt::Result { Ok(()) }
+}
+
+impl Into<String> for Foo<u8> {
+    fn into(self) -> String { String::new() }
+}
+
+impl<T> Foo<T> {
+    fn new() -> Self { Foo }
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(symbols) != 3 {
+		t.Fatalf("expected 3 impl blocks, got %d", len(symbols))
+	}
+
+	expected := [][]string{
+		{
+			"impl fmt::Display for Foo::fmt",
+			"impl Display for Foo::fmt",
+			"<Foo as fmt::Display>::fmt",
+			"<Foo as Display>::fmt",
+			"Foo::fmt",
+		},
+		{
+			"impl Into<String> for Foo::into",
+			"impl Into for Foo::into",
+			"<Foo as Into<String>>::into",
+			"<Foo as Into>::into",
+			"Foo::into",
+		},
+		{"impl Foo::new", "Foo::new"},
+	}
+	for i, sym := range symbols {
+		item := sym.(*Impl).Children()[0].(*Function)
+		if got := item.Selectors(); !slices.Equal(got, expected[i]) {
+			t.Errorf("impl[%d]: expected selectors %q, got %q", i, expected[i], got)
+		}
+	}
+}
@@ -8,10 +8,12 @@ import (
 
 // Function represents a Rust function or method
 type Function struct {
+	languages.Nesting
 	name       string
 	signature  string
 	receiver   string // For methods in impl blocks
 	traitImpl  string // Trait being implemented (if any)
+	inTrait    bool   // Declared in a trait body
 	visibility string
 	doc        string
 	loc        languages.Range
@@ -19,7 +21,7 @@ type Function struct {
 
 func (f *Function) Name() string { return f.name }
 func (f *Function) Kind() string {
-	if f.receiver != "" {
+	if f.receiver != "" || f.inTrait {
 		return "method"
 	}
 	return "func"
@@ -31,17 +33,6 @@ func (f *Function) String() string {
 		sb.WriteString(f.visibility)
 		sb.WriteString(" ")
 	}
-	if f.receiver != "" {
-		if f.traitImpl != "" {
-			sb.WriteString("impl ")
-			sb.WriteString(f.traitImpl)
-			sb.WriteString(" for ")
-		} else {
-			sb.WriteString("impl ")
-		}
-		sb.WriteString(f.receiver)
-		sb.WriteString(": ")
-	}
 	sb.WriteString("fn ")
 	sb.WriteString(f.name)
 	sb.WriteString(f.signature)
@@ -49,8 +40,65 @@ func (f *Function) String() string {
 }
 func (f *Function) DocComment() string { return f.doc }
 
+// Implements returns the trait item this method implements (e.g., "Handler.handle"),
+// or "" if it is not part of a trait impl
+func (f *Function) Implements() string { return traitItemPath(f.traitImpl, f.name) }
+
+// Selectors returns the impl-qualified names of an impl item
+func (f *Function) Selectors() []string { return implSelectors(f.receiver, f.traitImpl, f.name) }
+
+// implSelectors returns the selectors of an item in an impl block, e.g.
+// "impl fmt::Display for Foo::fmt", "<Foo as fmt::Display>::fmt" and "Foo::fmt".
+// Generic arguments are dropped from the type, and trait paths are also
+// accepted without module path or generics ("impl Display for Foo::fmt").
+func implSelectors(receiver, traitImpl, name string) []string {
+	if receiver == "" {
+		return nil
+	}
+	typeName := baseName(receiver)
+	if traitImpl == "" {
+		return []string{"impl " + typeName + "::" + name, typeName + "::" + name}
+	}
+	traits := []string{traitImpl}
+	if trait := baseName(traitImpl); trait != traitImpl {
+		traits = append(traits, trait)
+	}
+	var selectors []string
+	for _, trait := range traits {
+		selectors = append(selectors, "impl "+trait+" for "+typeName+"::"+name)
+	}
+	for _, trait := range traits {
+		selectors = append(selectors, "<"+typeName+" as "+trait+">::"+name)
+	}
+	return append(selectors, typeName+"::"+name)
+}
+
+// traitItemPath returns the qualified trait item name for an item in a trait impl.
+// Module paths and generic arguments are dropped from the trait (fmt::Display -> Display).
+func traitItemPath(traitImpl, name string) string {
+	if traitImpl == "" {
+		return ""
+	}
+	return baseName(traitImpl) + "." + name
+}
+
+// baseName strips generic arguments and the module path from a type or trait path
+// (e.g., "fmt::Display" -> "Display", "Vec<T>" -> "Vec", "&mut Foo" -> "Foo")
+func baseName(path string) string {
+	if idx := strings.Index(path, "<"); idx != -1 {
+		path = path[:idx]
+	}
+	if idx := strings.LastIndex(path, "::"); idx != -1 {
+		path = path[idx+2:]
+	}
+	path = strings.TrimPrefix(path, "&")
+	path = strings.TrimPrefix(path, "mut ")
+	return strings.TrimSpace(path)
+}
+
 // Struct represents a Rust struct
 type Struct struct {
+	languages.Nesting
 	name       string
 	visibility string
 	doc        string
@@ -72,8 +120,9 @@ func (s *Struct) String() string {
 }
 func (s *Struct) DocComment() string { return s.doc }
 
-// Enum represents a Rust enum
+// Enum represents a Rust enum. Its variants are its children.
 type Enum struct {
+	languages.Nesting
 	name       string
 	visibility string
 	doc        string
@@ -95,8 +144,24 @@ func (e *Enum) String() string {
 }
 func (e *Enum) DocComment() string { return e.doc }
 
-// Trait represents a Rust trait
+// Variant represents a Rust enum variant
+type Variant struct {
+	languages.Nesting
+	name  string
+	shape string // Payload or discriminant (e.g., "(f64)", " { w: f64 }", " = 3")
+	doc   string
+	loc   languages.Range
+}
+
+func (v *Variant) Name() string              { return v.name }
+func (v *Variant) Kind() string              { return "variant" }
+func (v *Variant) Location() languages.Range { return v.loc }
+func (v *Variant) String() string            { return v.name + v.shape }
+func (v *Variant) DocComment() string        { return v.doc }
+
+// Trait represents a Rust trait. Methods and associated types/consts are its children.
 type Trait struct {
+	languages.Nesting
 	name       string
 	visibility string
 	doc        string
@@ -118,9 +183,62 @@ func (t *Trait) String() string {
 }
 func (t *Trait) DocComment() string { return t.doc }
 
+// Impl represents an impl block. Its methods and associated items are its children.
+type Impl struct {
+	languages.Nesting
+	typeName   string // Implementing type as written (e.g., "Foo<T>")
+	traitName  string // Trait being implemented (if any)
+	typeParams string // Generic parameters of the impl (e.g., "<T>")
+	doc        string
+	loc        languages.Range
+}
+
+// Name returns the implementing type without generic arguments or module path,
+// so impl items are addressed like "Foo.new". The impl block itself is addressed
+// by its own selectors (see Selectors), leaving "Foo" to the type.
+func (i *Impl) Name() string              { return baseName(i.typeName) }
+func (i *Impl) Kind() string              { return "impl" }
+func (i *Impl) Location() languages.Range { return i.loc }
+func (i *Impl) String() string {
+	var sb strings.Builder
+	sb.WriteString("impl")
+	sb.WriteString(i.typeParams)
+	sb.WriteString(" ")
+	if i.traitName != "" {
+		sb.WriteString(i.traitName)
+		sb.WriteString(" for ")
+	}
+	sb.WriteString(i.typeName)
+	return sb.String()
+}
+func (i *Impl) DocComment() string { return i.doc }
+
+// Trait returns the implemented trait as written, or "" for inherent impls
+func (i *Impl) Trait() string { return i.traitName }
+
+// Extends returns the implementing type: impl blocks extend it
+func (i *Impl) Extends() string { return i.Name() }
+
+// Selectors returns the impl block without generic parameters (e.g.,
+// "impl Circle", "impl fmt::Display for Circle", "impl Display for Circle")
+func (i *Impl) Selectors() []string {
+	typeName := baseName(i.typeName)
+	if i.traitName == "" {
+		return []string{"impl " + typeName}
+	}
+	selectors := []string{"impl " + i.traitName + " for " + typeName}
+	if trait := baseName(i.traitName); trait != i.traitName {
+		selectors = append(selectors, "impl "+trait+" for "+typeName)
+	}
+	return selectors
+}
+
 // Const represents a Rust const item
 type Const struct {
+	languages.Nesting
 	name       string
+	receiver   string // For associated items in impl blocks
+	traitImpl  string // Trait being implemented (if any)
 	visibility string
 	doc        string
 	loc        languages.Range
@@ -141,8 +259,15 @@ func (c *Const) String() string {
 }
 func (c *Const) DocComment() string { return c.doc }
 
+// Implements returns the trait item this associated item implements, or ""
+func (c *Const) Implements() string { return traitItemPath(c.traitImpl, c.name) }
+
+// Selectors returns the impl-qualified names of an associated const
+func (c *Const) Selectors() []string { return implSelectors(c.receiver, c.traitImpl, c.name) }
+
 // Static represents a Rust static item
 type Static struct {
+	languages.Nesting
 	name       string
 	visibility string
 	doc        string
@@ -164,9 +289,12 @@ func (s *Static) String() string {
 }
 func (s *Static) DocComment() string { return s.doc }
 
-// TypeAlias represents a Rust type alias
+// TypeAlias represents a Rust type alias or associated type
 type TypeAlias struct {
+	languages.Nesting
 	name       string
+	receiver   string // For associated items in impl blocks
+	traitImpl  string // Trait being implemented (if any)
 	visibility string
 	doc        string
 	loc        languages.Range
@@ -187,8 +315,15 @@ func (t *TypeAlias) String() string {
 }
 func (t *TypeAlias) DocComment() string { return t.doc }
 
-// Mod represents a Rust module declaration
+// Implements returns the trait item this associated item implements, or ""
+func (t *TypeAlias) Implements() string { return traitItemPath(t.traitImpl, t.name) }
+
+// Selectors returns the impl-qualified names of an associated type
+func (t *TypeAlias) Selectors() []string { return implSelectors(t.receiver, t.traitImpl, t.name) }
+
+// Mod represents a Rust module declaration. Items of inline modules are its children.
 type Mod struct {
+	languages.Nesting
 	name       string
 	visibility string
 	doc        string
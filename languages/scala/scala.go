@@ -0,0 +1,208 @@
+package scala
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/scala"
+)
+
+func init() {
+	languages.Register(&Language{})
+}
+
+// Language implements the Scala language parser
+type Language struct{}
+
+func (l *Language) Name() string           { return "scala" }
+func (l *Language) Extensions() []string   { return []string{".scala", ".sc"} }
+func (l *Language) Interpreters() []string { return []string{"scala"} }
+
+func (l *Language) TreeSitterLang() *sitter.Language {
+	return scala.GetLanguage()
+}
+
+func (l *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(scala.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Scala file: %w", err)
+	}
+	defer tree.Close()
+
+	var imports []string
+	symbols := extractDefinitions(tree.RootNode(), content, &imports)
+
+	return imports, symbols, nil
+}
+
+// extractDefinitions extracts the packages, types and defs declared in a
+// compilation unit, packaging block or template body, and collects its imports
+func extractDefinitions(node *sitter.Node, content []byte, imports *[]string) []languages.Symbol {
+	var symbols []languages.Symbol
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "package_clause":
+			pkg := &Package{
+				name: languages.FieldContent(child, "name", content),
+				loc:  languages.NodeRange(child),
+			}
+			if body := child.ChildByFieldName("body"); body != nil {
+				for _, sym := range extractDefinitions(body, content, imports) {
+					languages.AddChild(pkg, sym)
+				}
+			}
+			symbols = append(symbols, pkg)
+		case "import_declaration":
+			*imports = append(*imports, extractImports(child, content)...)
+		case "object_definition", "class_definition", "trait_definition", "enum_definition":
+			symbols = append(symbols, extractType(child, content, imports))
+		case "function_definition", "function_declaration":
+			symbols = append(symbols, extractDef(child, content))
+		}
+	}
+
+	return symbols
+}
+
+// extractImports returns the imported names of an import declaration,
+// expanding selectors (import a.b.{C, D => E} imports a.b.C and a.b.D).
+// Wildcard imports end in "._".
+func extractImports(node *sitter.Node, content []byte) []string {
+	var path []string
+	var imports []string
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "identifier":
+			path = append(path, child.Content(content))
+		case "namespace_wildcard":
+			imports = append(imports, strings.Join(path, ".")+"._")
+		case "namespace_selectors":
+			for j := 0; j < int(child.NamedChildCount()); j++ {
+				selector := child.NamedChild(j)
+				name := selector.Content(content)
+				if selector.Type() == "arrow_renamed_identifier" {
+					name = languages.FieldContent(selector, "name", content)
+				}
+				imports = append(imports, strings.Join(path, ".")+"."+name)
+			}
+		}
+	}
+	if len(imports) == 0 && len(path) > 0 {
+		imports = append(imports, strings.Join(path, "."))
+	}
+	return imports
+}
+
+// extractType extracts an object, class, trait or enum with its defs and nested types
+func extractType(node *sitter.Node, content []byte, imports *[]string) languages.Symbol {
+	typ := &Type{
+		name:       languages.FieldContent(node, "name", content),
+		modifiers:  languages.Compact(contentOfType(node, "modifiers", content)),
+		typeParams: languages.Compact(languages.FieldContent(node, "type_parameters", content)),
+		params:     languages.Compact(languages.FieldContent(node, "class_parameters", content)),
+		extends:    languages.Compact(languages.FieldContent(node, "extend", content)),
+		doc:        extractDoc(node, content),
+		loc:        languages.NodeRange(node),
+	}
+
+	switch node.Type() {
+	case "object_definition":
+		typ.keyword = "object"
+	case "class_definition":
+		typ.keyword = "class"
+	case "trait_definition":
+		typ.keyword = "trait"
+	case "enum_definition":
+		typ.keyword = "enum"
+	}
+	if hasChildOfType(node, "case") {
+		typ.keyword = "case " + typ.keyword
+	}
+
+	if body := node.ChildByFieldName("body"); body != nil {
+		for _, member := range extractDefinitions(body, content, imports) {
+			languages.AddChild(typ, member)
+		}
+	}
+	return typ
+}
+
+// extractDef extracts a def, with or without a body
+func extractDef(node *sitter.Node, content []byte) languages.Symbol {
+	def := &Def{
+		name:       languages.FieldContent(node, "name", content),
+		modifiers:  languages.Compact(contentOfType(node, "modifiers", content)),
+		typeParams: languages.Compact(languages.FieldContent(node, "type_parameters", content)),
+		returnType: languages.Compact(languages.FieldContent(node, "return_type", content)),
+		doc:        extractDoc(node, content),
+		loc:        languages.NodeRange(node),
+	}
+
+	// Curried defs have a parameter list per clause
+	var params strings.Builder
+	for i := 0; i < int(node.ChildCount()); i++ {
+		if node.FieldNameForChild(i) == "parameters" {
+			params.WriteString(languages.Compact(node.Child(i).Content(content)))
+		}
+	}
+	def.params = params.String()
+
+	return def
+}
+
+// contentOfType returns the content of the first named child of the given type, or ""
+func contentOfType(node *sitter.Node, typ string, content []byte) string {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == typ {
+			return child.Content(content)
+		}
+	}
+	return ""
+}
+
+// hasChildOfType reports whether a node has a child (named or anonymous) of the given type
+func hasChildOfType(node *sitter.Node, typ string) bool {
+	for i := 0; i < int(node.ChildCount()); i++ {
+		if node.Child(i).Type() == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// extractDoc extracts the first line of the Scaladoc comment directly
+// preceding a definition
+func extractDoc(node *sitter.Node, content []byte) string {
+	prev := node.PrevNamedSibling()
+	if prev == nil || prev.Type() != "block_comment" || node.StartPoint().Row-prev.EndPoint().Row > 1 {
+		return ""
+	}
+
+	text := prev.Content(content)
+	if !strings.HasPrefix(text, "/**") {
+		return ""
+	}
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "/**"), "*/")
+
+	for line := range strings.SplitSeq(text, "\n") {
+		line = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*"))
+		if strings.HasPrefix(line, "@") {
+			// Tags (@param, @return) start after the description
+			return ""
+		}
+		if line != "" {
+			return line
+		}
+	}
+	return ""
+}
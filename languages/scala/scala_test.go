@@ -0,0 +1,165 @@
+package scala
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+	"github.com/roveo/topo-mcp/languages/languagetest"
+)
+
+func TestLanguageMetadata(t *testing.T) {
+	lang := &Language{}
+
+	if lang.Name() != "scala" {
+		t.Errorf("expected name 'scala', got %q", lang.Name())
+	}
+
+	exts := lang.Extensions()
+	if strings.Join(exts, ",") != ".scala,.sc" {
+		t.Errorf("expected extensions [.scala .sc], got %v", exts)
+	}
+}
+
+func TestParseDefinitions(t *testing.T) {
+	src := `package com.acme.jobs
+
+import org.apache.spark.sql.{DataFrame, SparkSession => Session}
+import scala.util._
+
+/** Daily aggregation job.
+  *
+  * Runs once per partition.
+  */
+object DailyJob extends App with Logging {
+  def run(spark: Session, date: String): DataFrame = {
+    spark.table("events")
+  }
+
+  private def helper[T](xs: Seq[T])(implicit ord: Ordering[T]): Seq[T] = xs.sorted
+}
+
+case class Event(id: Long, kind: String) {
+  def isClick: Boolean = kind == "click"
+}
+
+sealed trait Shape {
+  /** Area in square units. */
+  def area: Double
+}
+
+case object Empty extends Shape
+
+abstract class Base[T](val x: T) extends Shape
+`
+	lang := &Language{}
+	imports, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	wantImports := []string{"org.apache.spark.sql.DataFrame", "org.apache.spark.sql.SparkSession", "scala.util._"}
+	if strings.Join(imports, ",") != strings.Join(wantImports, ",") {
+		t.Errorf("expected imports %v, got %v", wantImports, imports)
+	}
+
+	if len(symbols) != 6 {
+		for _, s := range symbols {
+			t.Logf("  %s: %s", s.Kind(), s.String())
+		}
+		t.Fatalf("expected 6 symbols, got %d", len(symbols))
+	}
+
+	tests := []struct {
+		name      string
+		kind      string
+		str       string
+		startLine int
+		endLine   int
+	}{
+		{"com.acme.jobs", "package", "package com.acme.jobs", 0, 0},
+		{"DailyJob", "object", "object DailyJob extends App with Logging", 9, 15},
+		{"Event", "case_class", "case class Event(id: Long, kind: String)", 17, 19},
+		{"Shape", "trait", "sealed trait Shape", 21, 24},
+		{"Empty", "case_object", "case object Empty extends Shape", 26, 26},
+		{"Base", "class", "abstract class Base[T](val x: T) extends Shape", 28, 28},
+	}
+	for i, tt := range tests {
+		sym := symbols[i]
+		if sym.Name() != tt.name {
+			t.Errorf("symbol %d: expected name %q, got %q", i, tt.name, sym.Name())
+		}
+		if sym.Kind() != tt.kind {
+			t.Errorf("%s: expected kind %q, got %q", tt.name, tt.kind, sym.Kind())
+		}
+		if sym.String() != tt.str {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.str, sym.String())
+		}
+		loc := sym.Location()
+		if loc.Start.Line != tt.startLine || loc.End.Line != tt.endLine {
+			t.Errorf("%s: expected lines %d-%d, got %d-%d", tt.name, tt.startLine, tt.endLine, loc.Start.Line, loc.End.Line)
+		}
+	}
+
+	job := symbols[1].(*Type)
+	if job.DocComment() != "Daily aggregation job." {
+		t.Errorf("expected DailyJob doc 'Daily aggregation job.', got %q", job.DocComment())
+	}
+	wantDefs := []string{
+		"def run(spark: Session, date: String): DataFrame",
+		"private def helper[T](xs: Seq[T])(implicit ord: Ordering[T]): Seq[T]",
+	}
+	if got := languagetest.ChildStrings(job); strings.Join(got, "|") != strings.Join(wantDefs, "|") {
+		t.Errorf("expected DailyJob defs %v, got %v", wantDefs, got)
+	}
+
+	area := languages.ChildrenOf(symbols[3])[0].(*Def)
+	if area.Kind() != "def" || area.DocComment() != "Area in square units." {
+		t.Errorf("expected documented def area, got %s %q", area.Kind(), area.DocComment())
+	}
+	if languages.QualifiedName(area) != "Shape.area" {
+		t.Errorf("expected qualified name Shape.area, got %q", languages.QualifiedName(area))
+	}
+}
+
+func TestParsePackagingAndEnum(t *testing.T) {
+	src := `package acme {
+  enum Color {
+    case Red, Green
+  }
+
+  def greet(name: String): String = s"hi $name"
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 symbol, got %d", len(symbols))
+	}
+	pkg := symbols[0].(*Package)
+	want := []string{"enum Color", "def greet(name: String): String"}
+	if got := languagetest.ChildStrings(pkg); strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("expected package members %v, got %v", want, got)
+	}
+}
+
+func TestParseImportSelectors(t *testing.T) {
+	src := `import a.b.{C => D, E}
+import a.b.*
+import java.util.UUID
+`
+	lang := &Language{}
+	imports, _, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	want := []string{"a.b.C", "a.b.E", "a.b._", "java.util.UUID"}
+	if strings.Join(imports, ",") != strings.Join(want, ",") {
+		t.Errorf("expected imports %v, got %v", want, imports)
+	}
+}
@@ -0,0 +1,92 @@
+package scala
+
+import (
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Package represents a package clause. Definitions in a packaging block
+// (package a { ... }) are its children.
+type Package struct {
+	languages.Nesting
+	name string
+	loc  languages.Range
+}
+
+func (p *Package) Name() string              { return p.name }
+func (p *Package) Kind() string              { return "package" }
+func (p *Package) Location() languages.Range { return p.loc }
+func (p *Package) String() string            { return "package " + p.name }
+
+// Type represents an object, class, trait or enum. Defs and nested types are
+// its children.
+type Type struct {
+	languages.Nesting
+	name       string
+	keyword    string // "object", "class", "trait", "enum", "case class" or "case object"
+	modifiers  string // e.g., "sealed", "private abstract"
+	typeParams string // e.g., "[T]"
+	params     string // Class parameters (e.g., "(id: Long, kind: String)")
+	extends    string // e.g., "extends App with Logging"
+	doc        string
+	loc        languages.Range
+}
+
+func (t *Type) Name() string { return t.name }
+
+// Kind returns the keyword, as a single token for case classes and case
+// objects ("case_class", "case_object")
+func (t *Type) Kind() string              { return strings.ReplaceAll(t.keyword, " ", "_") }
+func (t *Type) Location() languages.Range { return t.loc }
+func (t *Type) String() string {
+	var sb strings.Builder
+	if t.modifiers != "" {
+		sb.WriteString(t.modifiers)
+		sb.WriteString(" ")
+	}
+	sb.WriteString(t.keyword)
+	sb.WriteString(" ")
+	sb.WriteString(t.name)
+	sb.WriteString(t.typeParams)
+	sb.WriteString(t.params)
+	if t.extends != "" {
+		sb.WriteString(" ")
+		sb.WriteString(t.extends)
+	}
+	return sb.String()
+}
+func (t *Type) DocComment() string { return t.doc }
+
+// Def represents a method, or a function at the top level of a file
+type Def struct {
+	languages.Nesting
+	name       string
+	modifiers  string // e.g., "private", "override"
+	typeParams string // e.g., "[T]"
+	params     string // All parameter lists (e.g., "(xs: Seq[T])(implicit ord: Ordering[T])")
+	returnType string
+	doc        string
+	loc        languages.Range
+}
+
+func (d *Def) Name() string              { return d.name }
+func (d *Def) Kind() string              { return "def" }
+func (d *Def) Location() languages.Range { return d.loc }
+func (d *Def) String() string {
+	var sb strings.Builder
+	if d.modifiers != "" {
+		sb.WriteString(d.modifiers)
+		sb.WriteString(" ")
+	}
+	sb.WriteString("def ")
+	sb.WriteString(d.name)
+	sb.WriteString(d.typeParams)
+	sb.WriteString(d.params)
+	if d.returnType != "" {
+		sb.WriteString(": ")
+		sb.WriteString(d.returnType)
+	}
+	return sb.String()
+}
+func (d *Def) DocComment() string { return d.doc }
@@ -0,0 +1,75 @@
+package languages
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Selectors returns every selector that addresses a symbol, most specific first:
+// the symbol's own extra selectors (see Addressable) followed by its
+// dot- and slash-separated paths. Extensions are only addressed by their own selectors.
+func Selectors(sym Symbol) []string {
+	var selectors []string
+	if a, ok := sym.(Addressable); ok {
+		selectors = append(selectors, a.Selectors()...)
+	}
+	if IsExtension(sym) {
+		return selectors
+	}
+	path := Path(sym)
+	selectors = append(selectors, strings.Join(path, "."))
+	if len(path) > 1 {
+		selectors = append(selectors, strings.Join(path, "/"))
+	}
+	return selectors
+}
+
+// IsExtension reports whether a symbol extends a symbol declared elsewhere (see Extension)
+func IsExtension(sym Symbol) bool {
+	ext, ok := sym.(Extension)
+	return ok && ext.Extends() != ""
+}
+
+// MatchesSelector reports whether any of the symbol's selectors equals selector
+func MatchesSelector(sym Symbol, selector string) bool {
+	for _, s := range Selectors(sym) {
+		if s == selector {
+			return true
+		}
+	}
+	return false
+}
+
+// SplitOrdinal splits a trailing "#N" ordinal off a selector
+// (e.g., "String#2" -> "String", 2). The ordinal is 0 if there is none.
+func SplitOrdinal(selector string) (string, int) {
+	idx := strings.LastIndex(selector, "#")
+	if idx <= 0 {
+		return selector, 0
+	}
+	n, err := strconv.Atoi(selector[idx+1:])
+	if err != nil || n < 1 {
+		return selector, 0
+	}
+	return selector[:idx], n
+}
+
+// Lookup returns all symbols addressed by a selector, in source order.
+// Symbols matching one of their selectors exactly take precedence; if there
+// are none, symbols other than extensions are matched by their plain name at
+// any nesting level. Ordinals are not interpreted (see SplitOrdinal).
+func Lookup(symbols []Symbol, selector string) []Symbol {
+	var exact, byName []Symbol
+	Walk(symbols, func(sym Symbol, _ int) {
+		switch {
+		case MatchesSelector(sym, selector):
+			exact = append(exact, sym)
+		case sym.Name() == selector && !IsExtension(sym):
+			byName = append(byName, sym)
+		}
+	})
+	if len(exact) > 0 {
+		return exact
+	}
+	return byName
+}
@@ -0,0 +1,85 @@
+package languages
+
+import "testing"
+
+// mockMethod is a test Symbol with extra selectors
+type mockMethod struct {
+	mockNode
+	receiver string
+}
+
+func (m *mockMethod) Selectors() []string {
+	return []string{"(" + m.receiver + ")." + m.name}
+}
+
+func TestSelectors(t *testing.T) {
+	run := Flatten(buildTree())[3]
+	got := Selectors(run)
+	want := []string{"Server.Handler.run", "Server/Handler/run"}
+	if len(got) != len(want) {
+		t.Fatalf("Selectors() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Selectors()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	method := &mockMethod{mockNode: mockNode{name: "Start"}, receiver: "*Server"}
+	if got := Selectors(method); len(got) != 2 || got[0] != "(*Server).Start" || got[1] != "Start" {
+		t.Errorf("Selectors() = %v, want [(*Server).Start Start]", got)
+	}
+}
+
+func TestSplitOrdinal(t *testing.T) {
+	tests := []struct {
+		selector string
+		base     string
+		ordinal  int
+	}{
+		{"String", "String", 0},
+		{"String#2", "String", 2},
+		{"Server.fmt#10", "Server.fmt", 10},
+		{"C#", "C#", 0},
+		{"String#0", "String#0", 0},
+		{"#2", "#2", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			base, ordinal := SplitOrdinal(tt.selector)
+			if base != tt.base || ordinal != tt.ordinal {
+				t.Errorf("SplitOrdinal(%q) = (%q, %d), want (%q, %d)",
+					tt.selector, base, ordinal, tt.base, tt.ordinal)
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	symbols := buildTree()
+	symbols = append(symbols,
+		&mockMethod{mockNode: mockNode{name: "run"}, receiver: "Worker"},
+		&mockMethod{mockNode: mockNode{name: "run"}, receiver: "*Pool"},
+	)
+
+	tests := []struct {
+		selector string
+		want     int
+	}{
+		{"Server.Handler.run", 1},
+		{"(*Pool).run", 1},
+		{"run", 2},   // Top-level matches take precedence over nested names
+		{"start", 1}, // Nested symbols match by plain name as a fallback
+		{"missing", 0},
+		{"run#2", 0}, // Ordinals are not interpreted
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			if got := Lookup(symbols, tt.selector); len(got) != tt.want {
+				t.Errorf("Lookup(%q) returned %d symbols, want %d", tt.selector, len(got), tt.want)
+			}
+		})
+	}
+}
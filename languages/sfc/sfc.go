@@ -0,0 +1,645 @@
+package sfc
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+	"github.com/roveo/topo-mcp/languages/typescript"
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/html"
+	"github.com/smacker/go-tree-sitter/javascript"
+	"github.com/smacker/go-tree-sitter/svelte"
+	"github.com/smacker/go-tree-sitter/typescript/tsx"
+	tsgrammar "github.com/smacker/go-tree-sitter/typescript/typescript"
+)
+
+func init() {
+	languages.Register(&VueLanguage{})
+	languages.Register(&SvelteLanguage{})
+}
+
+// VueLanguage implements Vue single-file component (.vue) parsing. Top-level
+// blocks are split with the HTML grammar.
+type VueLanguage struct{}
+
+func (v *VueLanguage) Name() string         { return "vue" }
+func (v *VueLanguage) Extensions() []string { return []string{".vue"} }
+func (v *VueLanguage) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	return v.ParseFile("", content)
+}
+
+// ParseFile parses a component, naming it after its file (e.g., "Button" for Button.vue)
+func (v *VueLanguage) ParseFile(path string, content []byte) ([]string, []languages.Symbol, error) {
+	return parse(path, content, html.GetLanguage(), "vue")
+}
+
+// SvelteLanguage implements Svelte component (.svelte) parsing
+type SvelteLanguage struct{}
+
+func (s *SvelteLanguage) Name() string         { return "svelte" }
+func (s *SvelteLanguage) Extensions() []string { return []string{".svelte"} }
+func (s *SvelteLanguage) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	return s.ParseFile("", content)
+}
+
+// ParseFile parses a component, naming it after its file (e.g., "Button" for Button.svelte)
+func (s *SvelteLanguage) ParseFile(path string, content []byte) ([]string, []languages.Symbol, error) {
+	return parse(path, content, svelte.GetLanguage(), "svelte")
+}
+
+// scriptParser pairs the parser for a script's symbols with the grammar
+// used to find the props and events it declares
+type scriptParser struct {
+	lang    languages.Language
+	grammar *sitter.Language
+}
+
+// scriptParsers maps the lang attribute of a <script> tag to its parser
+var scriptParsers = map[string]scriptParser{
+	"js":         {&typescript.JSLanguage{}, javascript.GetLanguage()},
+	"jsx":        {&typescript.JSXLanguage{}, javascript.GetLanguage()},
+	"ts":         {&typescript.TSLanguage{}, tsgrammar.GetLanguage()},
+	"typescript": {&typescript.TSLanguage{}, tsgrammar.GetLanguage()},
+	"tsx":        {&typescript.TSXLanguage{}, tsx.GetLanguage()},
+}
+
+func parse(path string, content []byte, grammar *sitter.Language, langName string) ([]string, []languages.Symbol, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(grammar)
+
+	tree, err := parser.ParseCtx(context.Background(), nil, content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse %s file: %w", langName, err)
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+	if root.NamedChildCount() == 0 {
+		return nil, nil, nil
+	}
+
+	var imports []string
+	var sections []*Section
+	var markup *Section
+	api := &componentAPI{}
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		child := root.NamedChild(i)
+		switch {
+		case child.Type() == "script_element":
+			section, scriptImports, err := extractScript(child, content, langName, api)
+			if err != nil {
+				return nil, nil, err
+			}
+			imports = append(imports, scriptImports...)
+			sections = append(sections, section)
+		case child.Type() == "style_element":
+			sections = append(sections, &Section{
+				name: "style",
+				kind: "style",
+				tag:  startTag(child, content),
+				loc:  languages.NodeRange(child),
+			})
+		case child.Type() == "comment" || child.Type() == "doctype":
+		case langName == "vue" && child.Type() == "element":
+			name := tagName(child, content)
+			kind := "block"
+			if name == "template" {
+				kind = "template"
+			}
+			sections = append(sections, &Section{
+				name: name,
+				kind: kind,
+				tag:  startTag(child, content),
+				loc:  languages.NodeRange(child),
+			})
+		case langName == "svelte":
+			// Svelte markup is not wrapped in a tag: everything outside
+			// <script> and <style> is one section
+			if child.Type() == "text" && strings.TrimSpace(child.Content(content)) == "" {
+				continue
+			}
+			if markup == nil {
+				markup = &Section{name: "markup", kind: "template", tag: "markup", loc: languages.NodeRange(child)}
+				sections = append(sections, markup)
+			}
+			markup.loc.End = languages.NodeRange(child).End
+		}
+	}
+
+	component := &Component{
+		name: "default",
+		loc: languages.Range{
+			Start: languages.NodeRange(root.NamedChild(0)).Start,
+			End:   languages.NodeRange(root.NamedChild(int(root.NamedChildCount()) - 1)).End,
+		},
+	}
+	if path != "" {
+		base := filepath.Base(path)
+		component.name = strings.TrimSuffix(base, filepath.Ext(base))
+	}
+	if api.name != "" {
+		component.name = api.name
+	}
+	if api.props != nil {
+		languages.AddChild(component, api.props)
+	}
+	if api.emits != nil {
+		languages.AddChild(component, api.emits)
+	}
+	for _, section := range sections {
+		languages.AddChild(component, section)
+	}
+
+	return imports, []languages.Symbol{component}, nil
+}
+
+// extractScript extracts a <script> section, with the symbols of its code as
+// children. The code is parsed in place so that symbol locations are
+// positions in the component file.
+func extractScript(node *sitter.Node, content []byte, langName string, api *componentAPI) (*Section, []string, error) {
+	attrs := attributes(node, content)
+
+	section := &Section{
+		name: "script",
+		kind: "script",
+		tag:  startTag(node, content),
+		loc:  languages.NodeRange(node),
+	}
+	_, setup := attrs["setup"]
+	_, module := attrs["module"]
+	switch {
+	case setup:
+		section.name = "script-setup"
+	case module || attrs["context"] == "module":
+		section.name = "script-module"
+		module = true
+	}
+
+	raw := languages.FirstChildOfType(node, "raw_text")
+	if raw == nil {
+		return section, nil, nil
+	}
+	lang := attrs["lang"]
+	if lang == "" {
+		lang = "js"
+	}
+	p, ok := scriptParsers[lang]
+	if !ok {
+		return section, nil, nil
+	}
+
+	code := mask(content, raw.StartByte(), raw.EndByte())
+	imports, symbols, err := p.lang.Parse(code)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse %s script: %w", langName, err)
+	}
+	for _, sym := range symbols {
+		languages.AddChild(section, sym)
+	}
+
+	// Module scripts run once per module and declare no props
+	if !module {
+		parser := sitter.NewParser()
+		defer parser.Close()
+		parser.SetLanguage(p.grammar)
+
+		tree, err := parser.ParseCtx(context.Background(), nil, code)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse %s script: %w", langName, err)
+		}
+		defer tree.Close()
+		api.collect(tree.RootNode(), code, langName == "svelte")
+	}
+
+	return section, imports, nil
+}
+
+// mask returns a copy of content with everything outside [start, end)
+// blanked out. Line breaks are kept, so positions in the copy are the same
+// as in content.
+func mask(content []byte, start, end uint32) []byte {
+	masked := make([]byte, len(content))
+	for i, b := range content {
+		switch {
+		case uint32(i) >= start && uint32(i) < end, b == '\n':
+			masked[i] = b
+		default:
+			masked[i] = ' '
+		}
+	}
+	return masked
+}
+
+// componentAPI collects the name, props and events a component declares
+// across its scripts
+type componentAPI struct {
+	name  string
+	props *API
+	emits *API
+}
+
+// collect finds the props and events declared by the top-level statements of a script:
+//
+//	defineProps<{ label: string }>() / defineProps(['label'])  (Vue <script setup>)
+//	defineEmits<{ (e: 'change', id: number): void }>()
+//	export default { props: {...}, emits: [...] }               (Vue options API)
+//	export let label: string                                    (Svelte)
+//	let { label } = $props()                                    (Svelte 5)
+//	createEventDispatcher<{ change: number }>()                 (Svelte)
+func (a *componentAPI) collect(program *sitter.Node, content []byte, svelte bool) {
+	for i := 0; i < int(program.NamedChildCount()); i++ {
+		stmt := program.NamedChild(i)
+		switch stmt.Type() {
+		case "expression_statement":
+			if expr := stmt.NamedChild(0); expr != nil {
+				a.collectCall(expr, stmt, program, content)
+			}
+		case "lexical_declaration", "variable_declaration":
+			for _, decl := range childrenOfType(stmt, "variable_declarator") {
+				value := decl.ChildByFieldName("value")
+				if value == nil {
+					continue
+				}
+				if callee(value, content) == "$props" {
+					a.add("props", stmt, patternProps(decl.ChildByFieldName("name"), content))
+					continue
+				}
+				a.collectCall(value, stmt, program, content)
+			}
+		case "export_statement":
+			if value := stmt.ChildByFieldName("value"); value != nil {
+				if callee(value, content) == "defineComponent" {
+					value = firstArgument(value)
+				}
+				a.collectOptions(value, content)
+				continue
+			}
+			// Svelte props are exported variables; exported constants and
+			// functions are not props
+			decl := stmt.ChildByFieldName("declaration")
+			if !svelte || decl == nil || !isMutableDeclaration(decl, content) {
+				continue
+			}
+			var members []*Member
+			for _, d := range childrenOfType(decl, "variable_declarator") {
+				members = append(members, &Member{
+					name:      d.ChildByFieldName("name").Content(content),
+					kind:      "prop",
+					signature: compact(d.Content(content)),
+					doc:       extractDoc(stmt, content),
+					loc:       languages.NodeRange(d),
+				})
+			}
+			a.add("props", stmt, members)
+		}
+	}
+}
+
+// collectCall collects the props or events declared by a compiler macro call
+func (a *componentAPI) collectCall(call *sitter.Node, stmt, program *sitter.Node, content []byte) {
+	if callee(call, content) == "withDefaults" {
+		call = firstArgument(call)
+	}
+	switch callee(call, content) {
+	case "defineProps":
+		a.add("props", stmt, macroMembers(call, program, content, "prop"))
+	case "defineEmits", "createEventDispatcher":
+		a.add("emits", stmt, macroMembers(call, program, content, "event"))
+	case "defineOptions":
+		a.collectOptions(firstArgument(call), content)
+	}
+}
+
+// collectOptions collects the name, props and emits of an options object
+func (a *componentAPI) collectOptions(options *sitter.Node, content []byte) {
+	if options == nil || options.Type() != "object" {
+		return
+	}
+	for _, pair := range childrenOfType(options, "pair") {
+		value := pair.ChildByFieldName("value")
+		switch keyName(pair.ChildByFieldName("key"), content) {
+		case "name":
+			if value.Type() == "string" {
+				a.name = unquote(value.Content(content))
+			}
+		case "props":
+			a.add("props", pair, runtimeMembers(value, content, "prop"))
+		case "emits":
+			a.add("emits", pair, runtimeMembers(value, content, "event"))
+		}
+	}
+}
+
+// add adds props or events declared by a statement, extending the range of
+// the props or emits to cover it
+func (a *componentAPI) add(kind string, stmt *sitter.Node, members []*Member) {
+	group := &a.props
+	if kind == "emits" {
+		group = &a.emits
+	}
+	if *group == nil {
+		*group = &API{kind: kind, loc: languages.NodeRange(stmt)}
+	} else {
+		(*group).loc.End = languages.NodeRange(stmt).End
+	}
+	for _, member := range members {
+		languages.AddChild(*group, member)
+	}
+}
+
+// macroMembers returns the members declared by a macro call, from its type
+// argument or its runtime argument
+func macroMembers(call *sitter.Node, program *sitter.Node, content []byte, kind string) []*Member {
+	if typeArgs := call.ChildByFieldName("type_arguments"); typeArgs != nil && typeArgs.NamedChildCount() > 0 {
+		return typeMembers(typeArgs.NamedChild(0), program, content, kind)
+	}
+	return runtimeMembers(firstArgument(call), content, kind)
+}
+
+// typeMembers returns the members of an object type, resolving the name of
+// an interface or type alias declared in the same script
+func typeMembers(node *sitter.Node, program *sitter.Node, content []byte, kind string) []*Member {
+	if node.Type() == "type_identifier" {
+		node = resolveType(program, node.Content(content), content)
+		if node == nil {
+			return nil
+		}
+	}
+
+	var members []*Member
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		member := &Member{
+			kind: kind,
+			doc:  extractDoc(child, content),
+			loc:  languages.NodeRange(child),
+		}
+		switch child.Type() {
+		case "property_signature":
+			member.name = keyName(child.ChildByFieldName("name"), content)
+			member.signature = compact(child.Content(content))
+		case "call_signature":
+			// (e: 'change', id: number): void declares the "change" event
+			params := childrenOfType(child.ChildByFieldName("parameters"), "required_parameter")
+			if len(params) == 0 {
+				continue
+			}
+			event := params[0].ChildByFieldName("type")
+			if event == nil {
+				continue
+			}
+			member.name = unquote(strings.TrimSpace(strings.TrimPrefix(event.Content(content), ":")))
+			var args []string
+			for _, param := range params[1:] {
+				args = append(args, compact(param.Content(content)))
+			}
+			member.signature = member.name + "(" + strings.Join(args, ", ") + ")"
+		default:
+			continue
+		}
+		members = append(members, member)
+	}
+	return members
+}
+
+// resolveType returns the body of the interface or object type alias with
+// the given name, or nil
+func resolveType(program *sitter.Node, name string, content []byte) *sitter.Node {
+	for i := 0; i < int(program.NamedChildCount()); i++ {
+		decl := program.NamedChild(i)
+		if decl.Type() == "export_statement" {
+			if decl = decl.ChildByFieldName("declaration"); decl == nil {
+				continue
+			}
+		}
+		nameNode := decl.ChildByFieldName("name")
+		if nameNode == nil || nameNode.Content(content) != name {
+			continue
+		}
+		switch decl.Type() {
+		case "interface_declaration":
+			return decl.ChildByFieldName("body")
+		case "type_alias_declaration":
+			if value := decl.ChildByFieldName("value"); value != nil && value.Type() == "object_type" {
+				return value
+			}
+		}
+	}
+	return nil
+}
+
+// runtimeMembers returns the members declared by a runtime props or emits
+// option: an array of names or an object keyed by name
+func runtimeMembers(node *sitter.Node, content []byte, kind string) []*Member {
+	if node == nil {
+		return nil
+	}
+	var members []*Member
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		member := &Member{
+			kind: kind,
+			doc:  extractDoc(child, content),
+			loc:  languages.NodeRange(child),
+		}
+		switch child.Type() {
+		case "string":
+			member.name = unquote(child.Content(content))
+			member.signature = member.name
+		case "pair":
+			member.name = keyName(child.ChildByFieldName("key"), content)
+			member.signature = member.name
+			// Prop definitions are short and say what the prop takes;
+			// event validators are code
+			if kind == "prop" {
+				member.signature = compact(child.Content(content))
+			}
+		case "shorthand_property_identifier":
+			member.name = child.Content(content)
+			member.signature = member.name
+		case "method_definition":
+			member.name = keyName(child.ChildByFieldName("name"), content)
+			member.signature = member.name
+		default:
+			continue
+		}
+		members = append(members, member)
+	}
+	return members
+}
+
+// patternProps returns the props destructured from $props()
+// (e.g., "label", "start = 0" and "class: className" in
+// let { label, start = 0, class: className } = $props())
+func patternProps(pattern *sitter.Node, content []byte) []*Member {
+	if pattern == nil || pattern.Type() != "object_pattern" {
+		return nil
+	}
+	var members []*Member
+	for i := 0; i < int(pattern.NamedChildCount()); i++ {
+		child := pattern.NamedChild(i)
+		var name string
+		switch child.Type() {
+		case "shorthand_property_identifier_pattern":
+			name = child.Content(content)
+		case "object_assignment_pattern":
+			name = child.ChildByFieldName("left").Content(content)
+		case "pair_pattern":
+			name = keyName(child.ChildByFieldName("key"), content)
+		default:
+			continue
+		}
+		members = append(members, &Member{
+			name:      name,
+			kind:      "prop",
+			signature: compact(child.Content(content)),
+			loc:       languages.NodeRange(child),
+		})
+	}
+	return members
+}
+
+// isMutableDeclaration reports whether a declaration is a let or var declaration
+func isMutableDeclaration(node *sitter.Node, content []byte) bool {
+	switch node.Type() {
+	case "variable_declaration":
+		return true
+	case "lexical_declaration":
+		kind := node.ChildByFieldName("kind")
+		return kind != nil && kind.Content(content) == "let"
+	}
+	return false
+}
+
+// callee returns the name of the function a call expression calls, or ""
+func callee(node *sitter.Node, content []byte) string {
+	if node == nil || node.Type() != "call_expression" {
+		return ""
+	}
+	if fn := node.ChildByFieldName("function"); fn != nil && fn.Type() == "identifier" {
+		return fn.Content(content)
+	}
+	return ""
+}
+
+// firstArgument returns the first argument of a call expression, or nil
+func firstArgument(call *sitter.Node) *sitter.Node {
+	if args := call.ChildByFieldName("arguments"); args != nil && args.NamedChildCount() > 0 {
+		return args.NamedChild(0)
+	}
+	return nil
+}
+
+// keyName returns the name of a property key, without quotes
+func keyName(node *sitter.Node, content []byte) string {
+	if node == nil {
+		return ""
+	}
+	if node.Type() == "string" {
+		return unquote(node.Content(content))
+	}
+	return node.Content(content)
+}
+
+// unquote strips the quotes of a string literal
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '`') {
+		return s[1 : len(s)-1]
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		return unquoted
+	}
+	return s
+}
+
+// compact returns code on one line, without a trailing separator
+func compact(s string) string {
+	return strings.TrimRight(languages.Compact(s), ";,")
+}
+
+// startTag returns the start tag of an element as written, on one line
+func startTag(node *sitter.Node, content []byte) string {
+	if tag := languages.FirstChildOfType(node, "start_tag"); tag != nil {
+		return compact(tag.Content(content))
+	}
+	return ""
+}
+
+// tagName returns the tag name of an element
+func tagName(node *sitter.Node, content []byte) string {
+	if tag := languages.FirstChildOfType(node, "start_tag"); tag != nil {
+		if name := languages.FirstChildOfType(tag, "tag_name"); name != nil {
+			return name.Content(content)
+		}
+	}
+	return ""
+}
+
+// attributes returns the attributes of an element's start tag. Attributes
+// without a value map to "".
+func attributes(node *sitter.Node, content []byte) map[string]string {
+	attrs := map[string]string{}
+	tag := languages.FirstChildOfType(node, "start_tag")
+	if tag == nil {
+		return attrs
+	}
+	for _, attr := range childrenOfType(tag, "attribute") {
+		name := languages.FirstChildOfType(attr, "attribute_name")
+		if name == nil {
+			continue
+		}
+		var value string
+		if v := languages.FirstChildOfType(attr, "quoted_attribute_value"); v != nil {
+			value = strings.Trim(v.Content(content), `"'`)
+		} else if v := languages.FirstChildOfType(attr, "attribute_value"); v != nil {
+			value = v.Content(content)
+		}
+		attrs[name.Content(content)] = value
+	}
+	return attrs
+}
+
+// childrenOfType returns the named children of the given type
+func childrenOfType(node *sitter.Node, typ string) []*sitter.Node {
+	if node == nil {
+		return nil
+	}
+	var children []*sitter.Node
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == typ {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// extractDoc extracts the first line of the comment directly preceding a
+// prop or event, skipping JSDoc tags
+func extractDoc(node *sitter.Node, content []byte) string {
+	prev := node.PrevNamedSibling()
+	if prev == nil || prev.Type() != "comment" || node.StartPoint().Row-prev.EndPoint().Row > 1 {
+		return ""
+	}
+
+	text := prev.Content(content)
+	switch {
+	case strings.HasPrefix(text, "/*"):
+		text = strings.TrimSuffix(strings.TrimLeft(text, "/*"), "*/")
+	case strings.HasPrefix(text, "//"):
+		text = strings.TrimPrefix(text, "//")
+	}
+
+	for line := range strings.SplitSeq(text, "\n") {
+		line = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*"))
+		if line != "" && !strings.HasPrefix(line, "@") {
+			return line
+		}
+	}
+	return ""
+}
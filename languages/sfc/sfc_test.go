@@ -0,0 +1,300 @@
+package sfc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+	"github.com/roveo/topo-mcp/languages/languagetest"
+)
+
+func TestLanguageMetadata(t *testing.T) {
+	vue := &VueLanguage{}
+	if vue.Name() != "vue" {
+		t.Errorf("expected name 'vue', got %q", vue.Name())
+	}
+	if exts := vue.Extensions(); len(exts) != 1 || exts[0] != ".vue" {
+		t.Errorf("expected extensions [.vue], got %v", exts)
+	}
+
+	svelte := &SvelteLanguage{}
+	if svelte.Name() != "svelte" {
+		t.Errorf("expected name 'svelte', got %q", svelte.Name())
+	}
+	if exts := svelte.Extensions(); len(exts) != 1 || exts[0] != ".svelte" {
+		t.Errorf("expected extensions [.svelte], got %v", exts)
+	}
+}
+
+func TestParseVueScriptSetup(t *testing.T) {
+	src := `<template>
+  <button @click="inc">{{ label }}: {{ count }}</button>
+</template>
+
+<script setup lang="ts">
+import { ref } from 'vue'
+
+const props = withDefaults(defineProps<{
+  /** Text shown on the button */
+  label: string
+  start?: number
+}>(), { start: 0 })
+const emit = defineEmits<{ (e: 'change', value: number): void; (e: 'reset'): void }>()
+
+const count = ref(props.start)
+
+/** Increments the counter */
+function inc() {
+  emit('change', ++count.value)
+}
+</script>
+
+<style scoped>
+button { color: red; }
+</style>
+`
+	lang := &VueLanguage{}
+	imports, symbols, err := lang.ParseFile("components/Counter.vue", []byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(imports) != 1 || imports[0] != "vue" {
+		t.Errorf("expected imports [vue], got %v", imports)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 component, got %d", len(symbols))
+	}
+	component := symbols[0]
+	if component.Kind() != "component" || component.Name() != "Counter" {
+		t.Errorf("expected component Counter, got %s %q", component.Kind(), component.Name())
+	}
+	if loc := component.Location(); loc.Start.Line != 0 || loc.End.Line != 24 {
+		t.Errorf("expected component on lines 0-24, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+
+	tests := []struct {
+		kind    string
+		str     string
+		members []string
+	}{
+		{"props", "props", []string{"label: string", "start?: number"}},
+		{"emits", "emits", []string{"change(value: number)", "reset()"}},
+		{"template", "<template>", nil},
+		{"script", `<script setup lang="ts">`, []string{"const props", "const emit", "const count", "function inc()"}},
+		{"style", "<style scoped>", nil},
+	}
+	children := languages.ChildrenOf(component)
+	if len(children) != len(tests) {
+		t.Fatalf("expected %d children, got %d", len(tests), len(children))
+	}
+	for i, tt := range tests {
+		child := children[i]
+		if child.Kind() != tt.kind || child.String() != tt.str {
+			t.Errorf("expected %s %q, got %s %q", tt.kind, tt.str, child.Kind(), child.String())
+		}
+		if members := languagetest.ChildStrings(child); strings.Join(members, "|") != strings.Join(tt.members, "|") {
+			t.Errorf("%s: expected members %v, got %v", tt.str, tt.members, members)
+		}
+	}
+
+	label := languages.Lookup(symbols, "Counter.props.label")
+	if len(label) != 1 {
+		t.Fatalf("expected to find Counter.props.label, got %d matches", len(label))
+	}
+	if doc := label[0].(*Member).DocComment(); doc != "Text shown on the button" {
+		t.Errorf("expected doc comment 'Text shown on the button', got %q", doc)
+	}
+
+	// Script symbols are located in the component file, not in the script
+	inc := languages.Lookup(symbols, "inc")
+	if len(inc) != 1 {
+		t.Fatalf("expected to find inc, got %d matches", len(inc))
+	}
+	if loc := inc[0].Location(); loc.Start.Line != 17 || loc.End.Line != 19 || loc.Start.Character != 0 {
+		t.Errorf("expected inc on lines 17-19, got %d:%d-%d", loc.Start.Line, loc.Start.Character, loc.End.Line)
+	}
+	if found := languages.Lookup(symbols, "Counter.script-setup.inc"); len(found) != 1 {
+		t.Errorf("expected inc to be addressable through its section, got %d matches", len(found))
+	}
+}
+
+func TestParseVueOptionsAPI(t *testing.T) {
+	src := `<template>
+  <div @click="$emit('select', user)">{{ user.name }}</div>
+</template>
+
+<script>
+export default {
+  name: 'UserCard',
+  props: {
+    // The user to show
+    user: { type: Object, required: true },
+    compact: Boolean,
+  },
+  emits: ['select'],
+}
+</script>
+`
+	lang := &VueLanguage{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	component := symbols[0]
+	if component.Name() != "UserCard" || component.String() != "component UserCard" {
+		t.Errorf("expected component UserCard, got %q", component.String())
+	}
+
+	props := languages.Lookup(symbols, "UserCard.props")
+	if len(props) != 1 {
+		t.Fatalf("expected to find UserCard.props, got %d matches", len(props))
+	}
+	want := []string{"user: { type: Object, required: true }", "compact: Boolean"}
+	if members := languagetest.ChildStrings(props[0]); strings.Join(members, "|") != strings.Join(want, "|") {
+		t.Errorf("expected props %v, got %v", want, members)
+	}
+	if loc := props[0].Location(); loc.Start.Line != 7 || loc.End.Line != 11 {
+		t.Errorf("expected props on lines 7-11, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+
+	if found := languages.Lookup(symbols, "select"); len(found) != 1 || found[0].Kind() != "event" {
+		t.Errorf("expected to find event select, got %v", found)
+	}
+}
+
+func TestParseVueTypeReference(t *testing.T) {
+	src := `<script setup lang="ts">
+export interface Props {
+  title: string
+  count?: number
+}
+defineProps<Props>()
+</script>
+`
+	lang := &VueLanguage{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	props := languages.Lookup(symbols, "default.props")
+	if len(props) != 1 {
+		t.Fatalf("expected to find default.props, got %d matches", len(props))
+	}
+	want := []string{"title: string", "count?: number"}
+	if members := languagetest.ChildStrings(props[0]); strings.Join(members, "|") != strings.Join(want, "|") {
+		t.Errorf("expected props %v, got %v", want, members)
+	}
+}
+
+func TestParseSvelte(t *testing.T) {
+	src := `<script context="module">
+  export const prerender = true;
+</script>
+
+<script lang="ts">
+  import { createEventDispatcher } from 'svelte';
+
+  /** Text shown on the button */
+  export let label: string;
+  export let start = 0;
+  export const version = 1;
+
+  let count = start;
+  const dispatch = createEventDispatcher<{ change: number }>();
+
+  function inc() {
+    dispatch('change', ++count);
+  }
+</script>
+
+<button on:click={inc}>{label}: {count}</button>
+
+<style>
+  button { color: red; }
+</style>
+`
+	lang := &SvelteLanguage{}
+	imports, symbols, err := lang.ParseFile("src/lib/Button.svelte", []byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(imports) != 1 || imports[0] != "svelte" {
+		t.Errorf("expected imports [svelte], got %v", imports)
+	}
+
+	tests := []struct {
+		kind    string
+		name    string
+		members []string
+	}{
+		{"props", "props", []string{"label: string", "start = 0"}},
+		{"emits", "emits", []string{"change: number"}},
+		{"script", "script-module", []string{"const prerender"}},
+		{"script", "script", []string{"let label", "let start", "const version", "let count", "const dispatch", "function inc()"}},
+		{"template", "markup", nil},
+		{"style", "style", nil},
+	}
+	children := languages.ChildrenOf(symbols[0])
+	if len(children) != len(tests) {
+		t.Fatalf("expected %d children, got %d", len(tests), len(children))
+	}
+	for i, tt := range tests {
+		child := children[i]
+		if child.Kind() != tt.kind || child.Name() != tt.name {
+			t.Errorf("expected %s %q, got %s %q", tt.kind, tt.name, child.Kind(), child.Name())
+		}
+		if members := languagetest.ChildStrings(child); strings.Join(members, "|") != strings.Join(tt.members, "|") {
+			t.Errorf("%s: expected members %v, got %v", tt.name, tt.members, members)
+		}
+	}
+
+	if symbols[0].Name() != "Button" {
+		t.Errorf("expected component Button, got %q", symbols[0].Name())
+	}
+	if doc := languages.Lookup(symbols, "Button.props.label")[0].(*Member).DocComment(); doc != "Text shown on the button" {
+		t.Errorf("expected doc comment 'Text shown on the button', got %q", doc)
+	}
+	if loc := children[4].Location(); loc.Start.Line != 20 || loc.End.Line != 20 {
+		t.Errorf("expected markup on line 20, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+}
+
+func TestParseSvelteRunes(t *testing.T) {
+	src := `<script lang="ts">
+  let { title, count = 0, class: className, ...rest } = $props();
+</script>
+
+{#if count > 0}
+  <p class={className}>{title}</p>
+{/if}
+`
+	lang := &SvelteLanguage{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	props := languages.Lookup(symbols, "default.props")
+	if len(props) != 1 {
+		t.Fatalf("expected to find default.props, got %d matches", len(props))
+	}
+	var names []string
+	for _, prop := range languages.ChildrenOf(props[0]) {
+		names = append(names, prop.Name())
+	}
+	if got := strings.Join(names, ","); got != "title,count,class" {
+		t.Errorf("expected props title, count, class, got %v", names)
+	}
+
+	// The script declares one variable per destructured prop
+	script := languages.Lookup(symbols, "default.script")
+	if len(script) != 1 {
+		t.Fatalf("expected to find default.script, got %d matches", len(script))
+	}
+	if got := languagetest.ChildStrings(script[0]); strings.Join(got, "|") != "let title|let count|let className|let rest" {
+		t.Errorf("expected one variable per prop, got %v", got)
+	}
+}
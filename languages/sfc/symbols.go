@@ -0,0 +1,71 @@
+package sfc
+
+import (
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Component represents a single-file component. Its props, emits and
+// top-level sections are its children.
+//
+// Components are named after their file (e.g., "Button" for Button.vue)
+// unless they declare a name option. Components parsed without a file name
+// are named "default", as the default export of their module.
+type Component struct {
+	languages.Nesting
+	name string
+	loc  languages.Range
+}
+
+func (c *Component) Name() string              { return c.name }
+func (c *Component) Kind() string              { return "component" }
+func (c *Component) Location() languages.Range { return c.loc }
+func (c *Component) String() string {
+	if c.name == "default" {
+		return "component"
+	}
+	return "component " + c.name
+}
+
+// Section represents a top-level <script>, <template> or <style> block, or
+// the markup of a Svelte component. Symbols declared in a script are its children.
+type Section struct {
+	languages.Nesting
+	name string // e.g., "script", "script-setup", "script-module", "template", "style"
+	kind string // "script", "template", "style" or "block" (for Vue custom blocks)
+	tag  string // Start tag as written (e.g., `<script setup lang="ts">`)
+	loc  languages.Range
+}
+
+func (s *Section) Name() string              { return s.name }
+func (s *Section) Kind() string              { return s.kind }
+func (s *Section) Location() languages.Range { return s.loc }
+func (s *Section) String() string            { return s.tag }
+
+// API represents the props or the events a component declares. Each prop or
+// event is its child.
+type API struct {
+	languages.Nesting
+	kind string // "props" or "emits"
+	loc  languages.Range
+}
+
+func (a *API) Name() string              { return a.kind }
+func (a *API) Kind() string              { return a.kind }
+func (a *API) Location() languages.Range { return a.loc }
+func (a *API) String() string            { return a.kind }
+
+// Member represents a single prop or event
+type Member struct {
+	languages.Nesting
+	name      string
+	kind      string // "prop" or "event"
+	signature string // e.g., "label: string", "start = 0", "change(id: number)"
+	doc       string
+	loc       languages.Range
+}
+
+func (m *Member) Name() string              { return m.name }
+func (m *Member) Kind() string              { return m.kind }
+func (m *Member) Location() languages.Range { return m.loc }
+func (m *Member) String() string            { return m.signature }
+func (m *Member) DocComment() string        { return m.doc }
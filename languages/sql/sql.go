@@ -0,0 +1,253 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/sql"
+)
+
+func init() {
+	languages.Register(&Language{})
+}
+
+// Language implements the SQL language parser. Only schema definitions
+// (DDL) are indexed; queries and data changes are not symbols.
+type Language struct{}
+
+func (l *Language) Name() string         { return "sql" }
+func (l *Language) Extensions() []string { return []string{".sql"} }
+
+func (l *Language) TreeSitterLang() *sitter.Language {
+	return sql.GetLanguage()
+}
+
+func (l *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(sql.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse SQL file: %w", err)
+	}
+	defer tree.Close()
+
+	return nil, extractStatements(tree.RootNode(), content), nil
+}
+
+// extractStatements extracts the symbols of the statements under node,
+// including those wrapped in a BEGIN ... COMMIT transaction
+func extractStatements(node *sitter.Node, content []byte) []languages.Symbol {
+	var symbols []languages.Symbol
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch {
+		case child.Type() == "transaction":
+			symbols = append(symbols, extractStatements(child, content)...)
+		case child.Type() == "statement" && child.NamedChildCount() > 0:
+			if sym := extractStatement(child.NamedChild(0), extractDoc(child, content), content); sym != nil {
+				symbols = append(symbols, sym)
+			}
+		}
+	}
+	return symbols
+}
+
+// extractStatement extracts the symbol defined by a DDL statement, or nil
+func extractStatement(node *sitter.Node, doc string, content []byte) languages.Symbol {
+	name, schema := objectName(languages.FirstChildOfType(node, "object_reference"), content)
+
+	switch node.Type() {
+	case "create_table":
+		table := &Table{
+			name:      name,
+			schema:    schema,
+			kind:      "table",
+			signature: signature(node, content, "column_definitions"),
+			doc:       doc,
+			loc:       languages.NodeRange(node),
+		}
+		if defs := languages.FirstChildOfType(node, "column_definitions"); defs != nil {
+			addColumns(table, defs, content)
+		}
+		return table
+	case "create_view", "create_materialized_view":
+		kind := "view"
+		if node.Type() == "create_materialized_view" {
+			kind = "materialized_view"
+		}
+		return &Table{
+			name:      name,
+			schema:    schema,
+			kind:      kind,
+			signature: signature(node, content, "keyword_as"),
+			doc:       doc,
+			loc:       languages.NodeRange(node),
+		}
+	case "alter_table":
+		// Only ALTER TABLE statements that add columns are indexed
+		table := &Table{
+			name:   name,
+			schema: schema,
+			kind:   "alter_table",
+			doc:    doc,
+			loc:    languages.NodeRange(node),
+		}
+		if ref := languages.FirstChildOfType(node, "object_reference"); ref != nil {
+			table.signature = languages.Compact(string(content[node.StartByte():ref.EndByte()]))
+		}
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			if action := node.NamedChild(i); action.Type() == "add_column" {
+				addColumns(table, action, content)
+			}
+		}
+		if len(table.Children()) == 0 {
+			return nil
+		}
+		return table
+	case "create_index":
+		// The index name is (confusingly) the grammar's "column" field
+		indexName := languages.FieldContent(node, "column", content)
+		if indexName == "" {
+			return nil
+		}
+		return &Index{
+			name:      unquote(indexName),
+			signature: signature(node, content),
+			doc:       doc,
+			loc:       languages.NodeRange(node),
+		}
+	case "create_function":
+		return &Function{
+			name:      name,
+			schema:    schema,
+			signature: signature(node, content, "function_body"),
+			doc:       doc,
+			loc:       languages.NodeRange(node),
+		}
+	case "create_trigger":
+		return &Trigger{
+			name:      name,
+			schema:    schema,
+			signature: signature(node, content, "keyword_for", "keyword_when", "keyword_execute", "keyword_begin"),
+			doc:       doc,
+			loc:       languages.NodeRange(node),
+		}
+	}
+	return nil
+}
+
+// addColumns adds the column definitions under node to a table
+func addColumns(table *Table, node *sitter.Node, content []byte) {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		def := node.NamedChild(i)
+		if def.Type() != "column_definition" {
+			continue
+		}
+		languages.AddChild(table, &Column{
+			name:       unquote(languages.FieldContent(def, "name", content)),
+			definition: languages.Compact(def.Content(content)),
+			doc:        extractColumnDoc(def, content),
+			loc:        languages.NodeRange(def),
+		})
+	}
+}
+
+// objectName returns the unquoted name and schema of an object reference
+func objectName(ref *sitter.Node, content []byte) (name, schema string) {
+	if ref == nil {
+		return "", ""
+	}
+	return unquote(languages.FieldContent(ref, "name", content)), unquote(languages.FieldContent(ref, "schema", content))
+}
+
+// unquote strips identifier quotes ("users", `users` or [users])
+func unquote(s string) string {
+	if len(s) < 2 {
+		return s
+	}
+	switch s[0] {
+	case '"', '`':
+		if s[len(s)-1] == s[0] {
+			return s[1 : len(s)-1]
+		}
+	case '[':
+		if s[len(s)-1] == ']' {
+			return s[1 : len(s)-1]
+		}
+	}
+	return s
+}
+
+// signature returns the statement as written, up to the first child of one
+// of the stop types (e.g., the column definitions), on one line
+func signature(node *sitter.Node, content []byte, stop ...string) string {
+	end := node.EndByte()
+	for i := 0; i < int(node.ChildCount()); i++ {
+		if child := node.Child(i); slices.Contains(stop, child.Type()) {
+			end = child.StartByte()
+			break
+		}
+	}
+	return languages.Compact(string(content[node.StartByte():end]))
+}
+
+// isComment reports whether a node is a -- line comment or a /* */ block comment
+func isComment(node *sitter.Node) bool {
+	return node.Type() == "comment" || node.Type() == "marginalia"
+}
+
+// commentText returns the text of a comment without its delimiters
+func commentText(node *sitter.Node, content []byte) string {
+	text := node.Content(content)
+	if strings.HasPrefix(text, "--") {
+		return strings.TrimPrefix(text, "--")
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+}
+
+// extractDoc extracts the first line of the comments (a run of -- lines or a
+// /* */ block) directly preceding a statement
+func extractDoc(node *sitter.Node, content []byte) string {
+	var lines []string
+	expectedRow := node.StartPoint().Row
+	for prev := node.PrevNamedSibling(); prev != nil && isComment(prev); prev = prev.PrevNamedSibling() {
+		if prev.EndPoint().Row+1 != expectedRow {
+			break
+		}
+		// Skip comments trailing the previous statement on the same line
+		if before := prev.PrevSibling(); before != nil && before.EndPoint().Row == prev.StartPoint().Row {
+			break
+		}
+		lines = append(strings.Split(commentText(prev, content), "\n"), lines...)
+		expectedRow = prev.StartPoint().Row
+	}
+	return firstLine(lines)
+}
+
+// extractColumnDoc extracts the comment documenting a column: a comment
+// trailing it on the same line, or else the comments directly preceding it
+func extractColumnDoc(node *sitter.Node, content []byte) string {
+	for next := node.NextSibling(); next != nil && next.StartPoint().Row == node.EndPoint().Row; next = next.NextSibling() {
+		if isComment(next) {
+			return firstLine([]string{commentText(next, content)})
+		}
+	}
+	return extractDoc(node, content)
+}
+
+// firstLine returns the first non-blank comment line, with any leading "*" of
+// block comment continuation lines removed
+func firstLine(lines []string) string {
+	for _, line := range lines {
+		if line = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*")); line != "" {
+			return line
+		}
+	}
+	return ""
+}
@@ -0,0 +1,198 @@
+package sql
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+	"github.com/roveo/topo-mcp/languages/languagetest"
+)
+
+func TestLanguageMetadata(t *testing.T) {
+	lang := &Language{}
+
+	if lang.Name() != "sql" {
+		t.Errorf("expected name 'sql', got %q", lang.Name())
+	}
+
+	exts := lang.Extensions()
+	if len(exts) != 1 || exts[0] != ".sql" {
+		t.Errorf("expected extensions [.sql], got %v", exts)
+	}
+}
+
+func TestParseCreateTable(t *testing.T) {
+	src := `-- Registered users.
+-- One row per account.
+CREATE TABLE IF NOT EXISTS public.users (
+    id BIGSERIAL PRIMARY KEY,
+    -- Login address
+    email VARCHAR(255) NOT NULL UNIQUE,
+    "display name" TEXT, -- shown in the UI
+    created_at TIMESTAMP DEFAULT now(),
+    CONSTRAINT users_email_check CHECK (email <> '')
+);
+`
+	lang := &Language{}
+	imports, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(imports) != 0 {
+		t.Errorf("expected no imports, got %v", imports)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 symbol, got %d", len(symbols))
+	}
+	table, ok := symbols[0].(*Table)
+	if !ok {
+		t.Fatalf("expected *Table, got %T", symbols[0])
+	}
+	if table.Name() != "users" || table.Kind() != "table" {
+		t.Errorf("expected table users, got %s %q", table.Kind(), table.Name())
+	}
+	if table.String() != "CREATE TABLE IF NOT EXISTS public.users" {
+		t.Errorf("unexpected String(): %q", table.String())
+	}
+	if table.DocComment() != "Registered users." {
+		t.Errorf("expected doc comment 'Registered users.', got %q", table.DocComment())
+	}
+	if loc := table.Location(); loc.Start.Line != 2 || loc.End.Line != 9 {
+		t.Errorf("expected table on lines 2-9, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+
+	expected := []string{
+		"id BIGSERIAL PRIMARY KEY",
+		"email VARCHAR(255) NOT NULL UNIQUE",
+		`"display name" TEXT`,
+		"created_at TIMESTAMP DEFAULT now()",
+	}
+	got := languagetest.ChildStrings(table)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected columns:\ngot:  %q\nwant: %q", got, expected)
+	}
+
+	docs := []string{"", "Login address", "shown in the UI", ""}
+	for i, col := range table.Children() {
+		if col.(*Column).DocComment() != docs[i] {
+			t.Errorf("column %q: expected doc comment %q, got %q", col.Name(), docs[i], col.(*Column).DocComment())
+		}
+	}
+
+	// Tables are addressable with and without their schema, columns through their table
+	for _, selector := range []string{"users", "public.users", "users.email", "users.display name"} {
+		if found := languages.Lookup(symbols, selector); len(found) != 1 {
+			t.Errorf("expected to find %s, got %d matches", selector, len(found))
+		}
+	}
+}
+
+func TestParseAlterTable(t *testing.T) {
+	src := `BEGIN;
+
+-- Track email verification
+ALTER TABLE users ADD COLUMN email_verified BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE users DROP COLUMN legacy_flag;
+ALTER TABLE IF EXISTS users ADD email_token TEXT, ADD COLUMN verified_at TIMESTAMP;
+
+COMMIT;
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	// ALTER TABLE statements that don't add columns are skipped
+	tests := []struct {
+		str     string
+		doc     string
+		columns []string
+	}{
+		{"ALTER TABLE users", "Track email verification", []string{"email_verified BOOLEAN NOT NULL DEFAULT false"}},
+		{"ALTER TABLE IF EXISTS users", "", []string{"email_token TEXT", "verified_at TIMESTAMP"}},
+	}
+
+	if len(symbols) != len(tests) {
+		t.Fatalf("expected %d symbols, got %d", len(tests), len(symbols))
+	}
+	for i, tt := range tests {
+		alter := symbols[i].(*Table)
+		if alter.Kind() != "alter_table" || alter.String() != tt.str {
+			t.Errorf("expected alter table %q, got %s %q", tt.str, alter.Kind(), alter.String())
+		}
+		if alter.DocComment() != tt.doc {
+			t.Errorf("%s: expected doc comment %q, got %q", alter.String(), tt.doc, alter.DocComment())
+		}
+		if got := languagetest.ChildStrings(alter); strings.Join(got, "\n") != strings.Join(tt.columns, "\n") {
+			t.Errorf("%s: unexpected columns %q, want %q", alter.String(), got, tt.columns)
+		}
+	}
+
+	found := languages.Lookup(symbols, "users.email_verified")
+	if len(found) != 1 || found[0].Location().Start.Line != 3 {
+		t.Errorf("expected to find users.email_verified on line 3, got %v", found)
+	}
+}
+
+func TestParseObjects(t *testing.T) {
+	src := `CREATE UNIQUE INDEX idx_users_email ON users (email);
+
+/* Users that logged in recently */
+CREATE VIEW active_users AS SELECT * FROM users WHERE active;
+
+CREATE MATERIALIZED VIEW daily_signups AS SELECT 1;
+
+CREATE OR REPLACE FUNCTION billing.touch_updated_at() RETURNS trigger AS $$
+BEGIN
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER users_touch BEFORE UPDATE ON users FOR EACH ROW EXECUTE FUNCTION billing.touch_updated_at();
+
+INSERT INTO users (email) VALUES ('admin@example.com');
+DROP TABLE old_users;
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	tests := []struct {
+		kind string
+		name string
+		str  string
+	}{
+		{"index", "idx_users_email", "CREATE UNIQUE INDEX idx_users_email ON users (email)"},
+		{"view", "active_users", "CREATE VIEW active_users"},
+		{"materialized_view", "daily_signups", "CREATE MATERIALIZED VIEW daily_signups"},
+		{"func", "touch_updated_at", "CREATE OR REPLACE FUNCTION billing.touch_updated_at() RETURNS trigger"},
+		{"trigger", "users_touch", "CREATE TRIGGER users_touch BEFORE UPDATE ON users"},
+	}
+
+	if len(symbols) != len(tests) {
+		t.Fatalf("expected %d symbols, got %d", len(tests), len(symbols))
+	}
+	for i, tt := range tests {
+		sym := symbols[i]
+		if sym.Kind() != tt.kind || sym.Name() != tt.name {
+			t.Errorf("expected %s %q, got %s %q", tt.kind, tt.name, sym.Kind(), sym.Name())
+		}
+		if sym.String() != tt.str {
+			t.Errorf("unexpected String(): got %q, want %q", sym.String(), tt.str)
+		}
+	}
+
+	if doc := symbols[1].(*Table).DocComment(); doc != "Users that logged in recently" {
+		t.Errorf("expected doc comment 'Users that logged in recently', got %q", doc)
+	}
+	if loc := symbols[3].Location(); loc.Start.Line != 7 || loc.End.Line != 11 {
+		t.Errorf("expected function on lines 7-11, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+	if found := languages.Lookup(symbols, "billing.touch_updated_at"); len(found) != 1 {
+		t.Errorf("expected to find billing.touch_updated_at, got %d matches", len(found))
+	}
+}
@@ -0,0 +1,117 @@
+package sql
+
+import (
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Table represents a CREATE TABLE, CREATE VIEW or ALTER TABLE ... ADD COLUMN
+// statement. Columns it defines (or adds) are its children.
+type Table struct {
+	languages.Nesting
+	name      string
+	schema    string // Schema qualifier as written, if any (e.g., "public")
+	kind      string // "table", "view", "materialized_view" or "alter_table"
+	signature string // The statement up to its body (e.g., "CREATE TABLE public.users")
+	doc       string
+	loc       languages.Range
+}
+
+func (t *Table) Name() string              { return t.name }
+func (t *Table) Kind() string              { return t.kind }
+func (t *Table) Location() languages.Range { return t.loc }
+func (t *Table) String() string            { return t.signature }
+func (t *Table) DocComment() string        { return t.doc }
+
+// Selectors returns the schema-qualified name. ALTER TABLE statements are
+// addressed as "alter table users", leaving "users" to the CREATE TABLE.
+func (t *Table) Selectors() []string {
+	if t.kind != "alter_table" {
+		return qualified(t.schema, t.name)
+	}
+	selectors := []string{"alter table " + t.name}
+	for _, name := range qualified(t.schema, t.name) {
+		selectors = append(selectors, "alter table "+name)
+	}
+	return selectors
+}
+
+// Extends returns the altered table for ALTER TABLE statements, "" otherwise
+func (t *Table) Extends() string {
+	if t.kind == "alter_table" {
+		return t.name
+	}
+	return ""
+}
+
+// Column represents a column definition in a CREATE TABLE or ALTER TABLE statement
+type Column struct {
+	languages.Nesting
+	name       string
+	definition string // e.g., "email VARCHAR(255) NOT NULL UNIQUE"
+	doc        string
+	loc        languages.Range
+}
+
+func (c *Column) Name() string              { return c.name }
+func (c *Column) Kind() string              { return "column" }
+func (c *Column) Location() languages.Range { return c.loc }
+func (c *Column) String() string            { return c.definition }
+func (c *Column) DocComment() string        { return c.doc }
+
+// Index represents a CREATE INDEX statement
+type Index struct {
+	languages.Nesting
+	name      string
+	signature string // e.g., "CREATE UNIQUE INDEX idx_users_email ON users (email)"
+	doc       string
+	loc       languages.Range
+}
+
+func (i *Index) Name() string              { return i.name }
+func (i *Index) Kind() string              { return "index" }
+func (i *Index) Location() languages.Range { return i.loc }
+func (i *Index) String() string            { return i.signature }
+func (i *Index) DocComment() string        { return i.doc }
+
+// Function represents a CREATE FUNCTION statement
+type Function struct {
+	languages.Nesting
+	name      string
+	schema    string
+	signature string // e.g., "CREATE FUNCTION add(a integer, b integer) RETURNS integer"
+	doc       string
+	loc       languages.Range
+}
+
+func (f *Function) Name() string              { return f.name }
+func (f *Function) Kind() string              { return "func" }
+func (f *Function) Location() languages.Range { return f.loc }
+func (f *Function) String() string            { return f.signature }
+func (f *Function) DocComment() string        { return f.doc }
+func (f *Function) Selectors() []string       { return qualified(f.schema, f.name) }
+
+// Trigger represents a CREATE TRIGGER statement
+type Trigger struct {
+	languages.Nesting
+	name      string
+	schema    string
+	signature string // e.g., "CREATE TRIGGER users_touch BEFORE UPDATE ON users"
+	doc       string
+	loc       languages.Range
+}
+
+func (t *Trigger) Name() string              { return t.name }
+func (t *Trigger) Kind() string              { return "trigger" }
+func (t *Trigger) Location() languages.Range { return t.loc }
+func (t *Trigger) String() string            { return t.signature }
+func (t *Trigger) DocComment() string        { return t.doc }
+func (t *Trigger) Selectors() []string       { return qualified(t.schema, t.name) }
+
+// qualified returns the schema-qualified selector of an object (e.g., "public.users"),
+// or nothing if the object is not qualified
+func qualified(schema, name string) []string {
+	if schema == "" {
+		return nil
+	}
+	return []string{schema + "." + name}
+}
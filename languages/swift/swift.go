@@ -0,0 +1,300 @@
+package swift
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/swift"
+)
+
+func init() {
+	languages.Register(&Language{})
+}
+
+// Language implements the Swift language parser
+type Language struct{}
+
+func (l *Language) Name() string           { return "swift" }
+func (l *Language) Extensions() []string   { return []string{".swift"} }
+func (l *Language) Interpreters() []string { return []string{"swift"} }
+
+func (l *Language) TreeSitterLang() *sitter.Language {
+	return swift.GetLanguage()
+}
+
+func (l *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(swift.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse Swift file: %w", err)
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	var imports []string
+	var symbols []languages.Symbol
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		child := root.NamedChild(i)
+		if child.Type() == "import_declaration" {
+			if id := languages.FirstChildOfType(child, "identifier"); id != nil {
+				imports = append(imports, id.Content(content))
+			}
+			continue
+		}
+		symbols = append(symbols, extractDeclaration(child, content, false)...)
+	}
+
+	return imports, symbols, nil
+}
+
+// extractDeclaration extracts the symbols of a top-level or member declaration
+func extractDeclaration(node *sitter.Node, content []byte, member bool) []languages.Symbol {
+	switch node.Type() {
+	case "class_declaration", "protocol_declaration":
+		return []languages.Symbol{extractType(node, content)}
+	case "function_declaration", "protocol_function_declaration":
+		kind := "func"
+		if member {
+			kind = "method"
+		}
+		return []languages.Symbol{extractFunction(node, content, languages.FieldContent(node, "name", content), kind)}
+	case "init_declaration":
+		return []languages.Symbol{extractFunction(node, content, "init", "constructor")}
+	case "deinit_declaration":
+		return []languages.Symbol{extractFunction(node, content, "deinit", "destructor")}
+	case "subscript_declaration":
+		return []languages.Symbol{extractFunction(node, content, "subscript", "subscript")}
+	case "property_declaration":
+		return extractProperties(node, content)
+	case "protocol_property_declaration":
+		pattern := node.ChildByFieldName("name")
+		if pattern == nil {
+			return nil
+		}
+		return []languages.Symbol{&Property{
+			name:      languages.FieldContent(pattern, "bound_identifier", content),
+			modifiers: extractModifiers(node, content),
+			signature: signature(node, content),
+			doc:       extractDoc(node, content),
+			loc:       languages.NodeRange(node),
+		}}
+	case "typealias_declaration", "associatedtype_declaration":
+		return []languages.Symbol{&TypeAlias{
+			name:      languages.FieldContent(node, "name", content),
+			modifiers: extractModifiers(node, content),
+			signature: signature(node, content),
+			doc:       extractDoc(node, content),
+			loc:       languages.NodeRange(node),
+		}}
+	}
+	return nil
+}
+
+// extractType extracts a type or extension declaration with its members
+func extractType(node *sitter.Node, content []byte) languages.Symbol {
+	kind := languages.FieldContent(node, "declaration_kind", content)
+	modifiers := extractModifiers(node, content)
+	sig := signature(node, content, "class_body", "protocol_body", "enum_class_body")
+	doc := extractDoc(node, content)
+
+	var container languages.Symbol
+	if kind == "extension" {
+		ext := &Extension{
+			typeName:  languages.FieldContent(node, "name", content),
+			modifiers: modifiers,
+			signature: sig,
+			doc:       doc,
+			loc:       languages.NodeRange(node),
+		}
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			if child := node.NamedChild(i); child.Type() == "inheritance_specifier" {
+				ext.protocols = append(ext.protocols, languages.Compact(child.Content(content)))
+			}
+		}
+		container = ext
+	} else {
+		container = &Type{
+			name:      languages.FieldContent(node, "name", content),
+			kind:      kind,
+			modifiers: modifiers,
+			signature: sig,
+			doc:       doc,
+			loc:       languages.NodeRange(node),
+		}
+	}
+
+	body := node.ChildByFieldName("body")
+	if body == nil {
+		return container
+	}
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		child := body.NamedChild(i)
+		var members []languages.Symbol
+		if child.Type() == "enum_entry" {
+			members = extractEnumCases(child, content)
+		} else {
+			members = extractDeclaration(child, content, true)
+		}
+		for _, member := range members {
+			languages.AddChild(container, member)
+		}
+	}
+	return container
+}
+
+// extractFunction extracts a function-like declaration
+func extractFunction(node *sitter.Node, content []byte, name, kind string) *Function {
+	return &Function{
+		name:      name,
+		kind:      kind,
+		modifiers: extractModifiers(node, content),
+		signature: signature(node, content, "function_body", "computed_property"),
+		doc:       extractDoc(node, content),
+		loc:       languages.NodeRange(node),
+	}
+}
+
+// extractProperties extracts each property of a property declaration
+// ("var x, y: Double" declares two). Destructuring patterns are skipped.
+func extractProperties(node *sitter.Node, content []byte) []languages.Symbol {
+	modifiers := extractModifiers(node, content)
+	doc := extractDoc(node, content)
+	mutability := ""
+	if binding := languages.FirstChildOfType(node, "value_binding_pattern"); binding != nil {
+		mutability = binding.Content(content)
+	}
+
+	var symbols []languages.Symbol
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		pattern := node.NamedChild(i)
+		if pattern.Type() != "pattern" {
+			continue
+		}
+		name := languages.FieldContent(pattern, "bound_identifier", content)
+		if name == "" {
+			continue
+		}
+		// The type annotation follows the last name it applies to
+		typeStr := ""
+		for j := i + 1; j < int(node.NamedChildCount()); j++ {
+			if next := node.NamedChild(j); next.Type() == "type_annotation" {
+				typeStr = languages.Compact(next.Content(content))
+				break
+			}
+		}
+		symbols = append(symbols, &Property{
+			name:      name,
+			modifiers: modifiers,
+			signature: mutability + " " + name + typeStr,
+			doc:       doc,
+			loc:       languages.NodeRange(node),
+		})
+	}
+	return symbols
+}
+
+// extractEnumCases extracts each case of an enum entry ("case north, south" declares two)
+func extractEnumCases(node *sitter.Node, content []byte) []languages.Symbol {
+	doc := extractDoc(node, content)
+	var cases []*EnumCase
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "simple_identifier":
+			cases = append(cases, &EnumCase{
+				name: child.Content(content),
+				doc:  doc,
+				loc:  languages.NodeRange(node),
+			})
+		case "enum_type_parameters":
+			if len(cases) > 0 {
+				cases[len(cases)-1].params = languages.Compact(child.Content(content))
+			}
+		}
+	}
+
+	symbols := make([]languages.Symbol, len(cases))
+	for i, c := range cases {
+		symbols[i] = c
+	}
+	return symbols
+}
+
+// extractModifiers extracts attributes (by name) and modifier keywords
+func extractModifiers(node *sitter.Node, content []byte) []string {
+	mods := languages.FirstChildOfType(node, "modifiers")
+	if mods == nil {
+		return nil
+	}
+	var modifiers []string
+	for i := 0; i < int(mods.NamedChildCount()); i++ {
+		child := mods.NamedChild(i)
+		if child.Type() == "attribute" {
+			if name := languages.FirstChildOfType(child, "user_type"); name != nil {
+				modifiers = append(modifiers, "@"+name.Content(content))
+			}
+			continue
+		}
+		modifiers = append(modifiers, child.Content(content))
+	}
+	return modifiers
+}
+
+// signature returns the declaration as written, from after its modifiers up to
+// the first child of one of the stop types (e.g., the body), on one line
+func signature(node *sitter.Node, content []byte, stop ...string) string {
+	start, end := node.StartByte(), node.EndByte()
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		if child.Type() == "modifiers" {
+			start = child.EndByte()
+			continue
+		}
+		if slices.Contains(stop, child.Type()) {
+			end = child.StartByte()
+			break
+		}
+	}
+	return languages.Compact(string(content[start:end]))
+}
+
+// extractDoc extracts the first line of the documentation comment (a run of
+// /// lines or a /** */ block) directly preceding a declaration
+func extractDoc(node *sitter.Node, content []byte) string {
+	var lines []string
+	expectedRow := node.StartPoint().Row
+	for prev := node.PrevNamedSibling(); prev != nil; prev = prev.PrevNamedSibling() {
+		text := prev.Content(content)
+		if prev.EndPoint().Row+1 != expectedRow {
+			break
+		}
+		if prev.Type() == "multiline_comment" && strings.HasPrefix(text, "/**") && len(lines) == 0 {
+			text = strings.TrimSuffix(strings.TrimPrefix(text, "/**"), "*/")
+			for line := range strings.SplitSeq(text, "\n") {
+				lines = append(lines, strings.TrimPrefix(strings.TrimSpace(line), "*"))
+			}
+			break
+		}
+		if prev.Type() != "comment" || !strings.HasPrefix(text, "///") {
+			break
+		}
+		lines = append([]string{strings.TrimPrefix(text, "///")}, lines...)
+		expectedRow = prev.StartPoint().Row
+	}
+
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
@@ -0,0 +1,220 @@
+package swift
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+	"github.com/roveo/topo-mcp/languages/languagetest"
+)
+
+func TestLanguageMetadata(t *testing.T) {
+	lang := &Language{}
+
+	if lang.Name() != "swift" {
+		t.Errorf("expected name 'swift', got %q", lang.Name())
+	}
+
+	exts := lang.Extensions()
+	if len(exts) != 1 || exts[0] != ".swift" {
+		t.Errorf("expected extensions [.swift], got %v", exts)
+	}
+}
+
+func TestParseImports(t *testing.T) {
+	src := `import Foundation
+import struct SwiftUI.Color
+@testable import App
+`
+	lang := &Language{}
+	imports, _, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	expected := []string{"Foundation", "SwiftUI.Color", "App"}
+	if strings.Join(imports, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected imports %v, got %v", expected, imports)
+	}
+}
+
+func TestParseClass(t *testing.T) {
+	src := `/// A user account.
+/// Stored in the local database.
+@available(iOS 15, *)
+public final class User: Base, Codable {
+    public let id: Int
+    var name: String = ""
+    var display: String { name }
+
+    init(id: Int) { self.id = id }
+    deinit {}
+
+    /// Compare by id.
+    public func compare(_ other: User) -> Bool {
+        id < other.id
+    }
+
+    subscript(index: Int) -> String { "" }
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 symbol, got %d", len(symbols))
+	}
+	cls, ok := symbols[0].(*Type)
+	if !ok {
+		t.Fatalf("expected *Type, got %T", symbols[0])
+	}
+	want := "@available public final class User: Base, Codable"
+	if cls.String() != want {
+		t.Errorf("unexpected String():\ngot:  %q\nwant: %q", cls.String(), want)
+	}
+	if cls.DocComment() != "A user account." {
+		t.Errorf("expected doc comment 'A user account.', got %q", cls.DocComment())
+	}
+
+	expected := []string{
+		"public let id: Int",
+		"var name: String",
+		"var display: String",
+		"init(id: Int)",
+		"deinit",
+		"public func compare(_ other: User) -> Bool",
+		"subscript(index: Int) -> String",
+	}
+	got := languagetest.ChildStrings(cls)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected members:\ngot:  %q\nwant: %q", got, expected)
+	}
+
+	kinds := []string{"property", "property", "property", "constructor", "destructor", "method", "subscript"}
+	for i, m := range cls.Children() {
+		if m.Kind() != kinds[i] {
+			t.Errorf("member %q: expected kind %q, got %q", m.Name(), kinds[i], m.Kind())
+		}
+	}
+
+	compare := cls.Children()[5].(*Function)
+	if compare.DocComment() != "Compare by id." {
+		t.Errorf("expected doc comment 'Compare by id.', got %q", compare.DocComment())
+	}
+	if loc := compare.Location(); loc.Start.Line != 12 || loc.End.Line != 14 {
+		t.Errorf("expected compare on lines 12-14, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+}
+
+func TestParseProtocolEnumStruct(t *testing.T) {
+	src := `protocol Shape: AnyObject {
+    associatedtype Unit
+    var area: Double { get }
+    func draw(in ctx: Context) throws
+}
+
+enum Direction: String {
+    case north, south
+    case custom(String)
+    func flip() -> Direction { self }
+}
+
+struct Point { var x, y: Double }
+
+typealias Handler = (String) -> Void
+
+func fetch<T: Decodable>(url: URL) async throws -> T { fatalError() }
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	tests := []struct {
+		kind    string
+		str     string
+		members []string
+	}{
+		{"protocol", "protocol Shape: AnyObject", []string{"associatedtype Unit", "var area: Double { get }", "func draw(in ctx: Context) throws"}},
+		{"enum", "enum Direction: String", []string{"case north", "case south", "case custom(String)", "func flip() -> Direction"}},
+		{"struct", "struct Point", []string{"var x: Double", "var y: Double"}},
+		{"type", "typealias Handler = (String) -> Void", nil},
+		{"func", "func fetch<T: Decodable>(url: URL) async throws -> T", nil},
+	}
+
+	if len(symbols) != len(tests) {
+		t.Fatalf("expected %d symbols, got %d", len(tests), len(symbols))
+	}
+	for i, tt := range tests {
+		sym := symbols[i]
+		if sym.Kind() != tt.kind {
+			t.Errorf("%s: expected kind %q, got %q", sym.Name(), tt.kind, sym.Kind())
+		}
+		if sym.String() != tt.str {
+			t.Errorf("unexpected String(): got %q, want %q", sym.String(), tt.str)
+		}
+		if got := languagetest.ChildStrings(sym); strings.Join(got, "\n") != strings.Join(tt.members, "\n") {
+			t.Errorf("%s: unexpected members %q, want %q", sym.Name(), got, tt.members)
+		}
+	}
+}
+
+func TestParseExtension(t *testing.T) {
+	src := `struct Box<T> {
+    var value: T
+}
+
+/// Equality for boxes
+extension Box: Equatable where T: Equatable {
+    static func == (lhs: Box, rhs: Box) -> Bool { lhs.value == rhs.value }
+    var isEmpty: Bool { false }
+}
+
+extension Box: CustomStringConvertible {
+    var description: String { "Box" }
+}
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 3 {
+		t.Fatalf("expected 3 symbols, got %d", len(symbols))
+	}
+	ext, ok := symbols[1].(*Extension)
+	if !ok {
+		t.Fatalf("expected *Extension, got %T", symbols[1])
+	}
+	if ext.Name() != "Box" || ext.Kind() != "extension" {
+		t.Errorf("expected extension named Box, got %s %q", ext.Kind(), ext.Name())
+	}
+	if ext.String() != "extension Box: Equatable where T: Equatable" {
+		t.Errorf("unexpected String(): %q", ext.String())
+	}
+	if ext.DocComment() != "Equality for boxes" {
+		t.Errorf("expected doc comment 'Equality for boxes', got %q", ext.DocComment())
+	}
+
+	// Extension members are attributed to the extended type
+	found := languages.Lookup(symbols, "Box.isEmpty")
+	if len(found) != 1 || found[0].Kind() != "property" {
+		t.Fatalf("expected to find property Box.isEmpty, got %v", found)
+	}
+	if found := languages.Lookup(symbols, "Box.=="); len(found) != 1 || found[0].Kind() != "method" {
+		t.Errorf("expected to find method Box.==, got %v", found)
+	}
+
+	// Extensions are told apart by their conformances
+	if found := languages.Lookup(symbols, "extension Box: CustomStringConvertible"); len(found) != 1 || found[0] != symbols[2] {
+		t.Errorf("expected to find the CustomStringConvertible extension, got %v", found)
+	}
+	if found := languages.Lookup(symbols, "extension Box"); len(found) != 2 {
+		t.Errorf("expected extension Box to find both extensions, got %d", len(found))
+	}
+}
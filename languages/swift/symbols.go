@@ -0,0 +1,137 @@
+package swift
+
+import (
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Type represents a class, struct, enum, actor or protocol. Members are its children.
+type Type struct {
+	languages.Nesting
+	name      string
+	kind      string   // "class", "struct", "enum", "actor" or "protocol"
+	modifiers []string // Attributes and modifier keywords (e.g., "@MainActor", "public")
+	signature string   // The declaration as written, up to the body (e.g., "class User: Base, Codable")
+	doc       string
+	loc       languages.Range
+}
+
+func (t *Type) Name() string              { return t.name }
+func (t *Type) Kind() string              { return t.kind }
+func (t *Type) Location() languages.Range { return t.loc }
+func (t *Type) String() string            { return withModifiers(t.modifiers, t.signature) }
+func (t *Type) DocComment() string        { return t.doc }
+
+// Extension represents an extension. Its members are its children.
+type Extension struct {
+	languages.Nesting
+	typeName  string   // Extended type as written (e.g., "Array.Index", "Box<Int>")
+	protocols []string // Conformances the extension adds (e.g., ["Equatable"])
+	modifiers []string
+	signature string // e.g., "extension User: Equatable"
+	doc       string
+	loc       languages.Range
+}
+
+// Name returns the extended type without generic arguments, so extension
+// members are addressed like members of the type itself (e.g., "User.isNew")
+func (e *Extension) Name() string              { return baseName(e.typeName) }
+func (e *Extension) Kind() string              { return "extension" }
+func (e *Extension) Location() languages.Range { return e.loc }
+func (e *Extension) String() string            { return withModifiers(e.modifiers, e.signature) }
+func (e *Extension) DocComment() string        { return e.doc }
+
+// Extends returns the extended type
+func (e *Extension) Extends() string { return e.Name() }
+
+// Selectors returns the extension header without generic arguments or where
+// clause (e.g., "extension User: Equatable") and "extension User", leaving
+// "User" to the type
+func (e *Extension) Selectors() []string {
+	selectors := []string{"extension " + e.Name()}
+	if len(e.protocols) > 0 {
+		selectors = append([]string{selectors[0] + ": " + strings.Join(e.protocols, ", ")}, selectors...)
+	}
+	return selectors
+}
+
+// Function represents a function, method, initializer, deinitializer or subscript
+type Function struct {
+	languages.Nesting
+	name      string
+	kind      string // "func", "method", "constructor", "destructor" or "subscript"
+	modifiers []string
+	signature string // e.g., "func compare(_ other: User) -> Bool"
+	doc       string
+	loc       languages.Range
+}
+
+func (f *Function) Name() string              { return f.name }
+func (f *Function) Kind() string              { return f.kind }
+func (f *Function) Location() languages.Range { return f.loc }
+func (f *Function) String() string            { return withModifiers(f.modifiers, f.signature) }
+func (f *Function) DocComment() string        { return f.doc }
+
+// Property represents a stored or computed property, or a protocol property requirement
+type Property struct {
+	languages.Nesting
+	name      string
+	modifiers []string
+	signature string // e.g., "let id: Int", "var area: Double { get }"
+	doc       string
+	loc       languages.Range
+}
+
+func (p *Property) Name() string              { return p.name }
+func (p *Property) Kind() string              { return "property" }
+func (p *Property) Location() languages.Range { return p.loc }
+func (p *Property) String() string            { return withModifiers(p.modifiers, p.signature) }
+func (p *Property) DocComment() string        { return p.doc }
+
+// TypeAlias represents a typealias or an associatedtype
+type TypeAlias struct {
+	languages.Nesting
+	name      string
+	modifiers []string
+	signature string // e.g., "typealias Handler = (String) -> Void"
+	doc       string
+	loc       languages.Range
+}
+
+func (t *TypeAlias) Name() string              { return t.name }
+func (t *TypeAlias) Kind() string              { return "type" }
+func (t *TypeAlias) Location() languages.Range { return t.loc }
+func (t *TypeAlias) String() string            { return withModifiers(t.modifiers, t.signature) }
+func (t *TypeAlias) DocComment() string        { return t.doc }
+
+// EnumCase represents an enum case
+type EnumCase struct {
+	languages.Nesting
+	name   string
+	params string // Associated values (e.g., "(String)")
+	doc    string
+	loc    languages.Range
+}
+
+func (c *EnumCase) Name() string              { return c.name }
+func (c *EnumCase) Kind() string              { return "constant" }
+func (c *EnumCase) Location() languages.Range { return c.loc }
+func (c *EnumCase) String() string            { return "case " + c.name + c.params }
+func (c *EnumCase) DocComment() string        { return c.doc }
+
+// baseName strips generic arguments from a type (e.g., "Box<Int>" -> "Box")
+func baseName(typ string) string {
+	if idx := strings.Index(typ, "<"); idx != -1 {
+		return typ[:idx]
+	}
+	return typ
+}
+
+// withModifiers prefixes a signature with attributes and modifier keywords
+func withModifiers(modifiers []string, signature string) string {
+	if len(modifiers) == 0 {
+		return signature
+	}
+	return strings.Join(modifiers, " ") + " " + signature
+}
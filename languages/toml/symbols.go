@@ -0,0 +1,47 @@
+package toml
+
+import (
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Table represents a table ("[tool.pytest.ini_options]") or an element of an
+// array of tables ("[[bin]]"). It is named by its dotted key, and its pairs are
+// its children.
+type Table struct {
+	languages.Nesting
+	name   string
+	header string // Header as written (e.g., "[tool.pytest.ini_options]")
+	kind   string // "table" or "array_table"
+	doc    string
+	loc    languages.Range
+}
+
+func (t *Table) Name() string              { return t.name }
+func (t *Table) Kind() string              { return t.kind }
+func (t *Table) Location() languages.Range { return t.loc }
+func (t *Table) String() string            { return t.header }
+func (t *Table) DocComment() string        { return t.doc }
+
+// Key represents a key/value pair. Dotted keys keep their dotted name, and
+// pairs of an inline table are its children.
+type Key struct {
+	languages.Nesting
+	name  string
+	kind  string // "object" (inline table), "array" or "key" (for other values)
+	value string // Short value as written (e.g., `"3.12"`), if any
+	doc   string
+	loc   languages.Range
+}
+
+func (k *Key) Name() string              { return k.name }
+func (k *Key) Kind() string              { return k.kind }
+func (k *Key) Location() languages.Range { return k.loc }
+func (k *Key) DocComment() string        { return k.doc }
+
+// String returns the key, followed by its value if it is short
+func (k *Key) String() string {
+	if k.value == "" {
+		return k.name
+	}
+	return k.name + " = " + k.value
+}
@@ -0,0 +1,201 @@
+package toml
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/toml"
+)
+
+func init() {
+	languages.Register(&Language{})
+}
+
+// Language implements TOML parsing. Tables and keys are indexed as a symbol tree.
+type Language struct{}
+
+func (l *Language) Name() string         { return "toml" }
+func (l *Language) Extensions() []string { return []string{".toml"} }
+
+func (l *Language) TreeSitterLang() *sitter.Language {
+	return toml.GetLanguage()
+}
+
+// maxValueLen is the longest value shown in a key's String()
+const maxValueLen = 60
+
+func (l *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(toml.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse TOML file: %w", err)
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	var symbols []languages.Symbol
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		child := root.NamedChild(i)
+		switch child.Type() {
+		case "pair":
+			symbols = append(symbols, extractPair(child, content))
+		case "table", "table_array_element":
+			symbols = append(symbols, extractTable(child, content))
+		}
+	}
+
+	return nil, symbols, nil
+}
+
+// extractTable extracts a table or an array of tables element with its pairs
+func extractTable(node *sitter.Node, content []byte) *Table {
+	table := &Table{
+		name: keyName(firstKey(node), content),
+		kind: "table",
+		doc:  extractDoc(node, content),
+		loc:  trimmedRange(node, content),
+	}
+	if node.Type() == "table_array_element" {
+		table.kind = "array_table"
+		table.header = "[[" + table.name + "]]"
+	} else {
+		table.header = "[" + table.name + "]"
+	}
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == "pair" {
+			languages.AddChild(table, extractPair(child, content))
+		}
+	}
+	return table
+}
+
+// extractPair extracts a key/value pair, with the pairs of an inline table as children
+func extractPair(node *sitter.Node, content []byte) *Key {
+	key := &Key{
+		name: keyName(firstKey(node), content),
+		kind: "key",
+		doc:  extractDoc(node, content),
+		loc:  languages.NodeRange(node),
+	}
+
+	value := node.NamedChild(int(node.NamedChildCount()) - 1)
+	switch value.Type() {
+	case "inline_table":
+		key.kind = "object"
+		for i := 0; i < int(value.NamedChildCount()); i++ {
+			if child := value.NamedChild(i); child.Type() == "pair" {
+				languages.AddChild(key, extractPair(child, content))
+			}
+		}
+	case "array":
+		key.kind = "array"
+	default:
+		if text := value.Content(content); len(text) <= maxValueLen && !strings.Contains(text, "\n") {
+			key.value = text
+		}
+	}
+	return key
+}
+
+// firstKey returns the key of a pair or the header key of a table
+func firstKey(node *sitter.Node) *sitter.Node {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		switch child := node.NamedChild(i); child.Type() {
+		case "bare_key", "quoted_key", "dotted_key":
+			return child
+		}
+	}
+	return nil
+}
+
+// keyName returns a bare, quoted or dotted key with its parts unquoted and
+// joined by "." (e.g., "tool.pytest.ini_options")
+func keyName(node *sitter.Node, content []byte) string {
+	if node == nil {
+		return ""
+	}
+	switch node.Type() {
+	case "dotted_key":
+		var parts []string
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			parts = append(parts, keyName(node.NamedChild(i), content))
+		}
+		return strings.Join(parts, ".")
+	case "quoted_key":
+		return unquote(node.Content(content))
+	}
+	return node.Content(content)
+}
+
+// unquote strips the quotes of a basic or literal quoted key
+func unquote(s string) string {
+	if strings.HasPrefix(s, "'") {
+		return strings.Trim(s, "'")
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		return unquoted
+	}
+	return strings.Trim(s, `"`)
+}
+
+// trimmedRange returns the range of a node without trailing comments and
+// whitespace. Tables extend over comments up to the next table.
+func trimmedRange(node *sitter.Node, content []byte) languages.Range {
+	r := languages.NodeRange(node)
+	text := bytes.TrimRight(content[node.StartByte():lastToken(node).EndByte()], " \t\r\n")
+	r.End.Line = r.Start.Line + bytes.Count(text, []byte("\n"))
+	if idx := bytes.LastIndexByte(text, '\n'); idx != -1 {
+		r.End.Character = len(text) - idx - 1
+	} else {
+		r.End.Character = r.Start.Character + len(text)
+	}
+	return r
+}
+
+// lastToken returns the last descendant of a node that is not a comment
+func lastToken(node *sitter.Node) *sitter.Node {
+	for i := int(node.ChildCount()) - 1; i >= 0; i-- {
+		if child := node.Child(i); child.Type() != "comment" {
+			return lastToken(child)
+		}
+	}
+	return node
+}
+
+// extractDoc extracts the first line of the # comments directly preceding a
+// table or pair
+func extractDoc(node *sitter.Node, content []byte) string {
+	// Comments before a table are parsed as the end of the previous table
+	prev := node.PrevNamedSibling()
+	if prev != nil && (prev.Type() == "table" || prev.Type() == "table_array_element") {
+		prev = prev.NamedChild(int(prev.NamedChildCount()) - 1)
+	}
+
+	var lines []string
+	expectedRow := node.StartPoint().Row
+	for ; prev != nil && prev.Type() == "comment"; prev = prev.PrevNamedSibling() {
+		if prev.EndPoint().Row+1 != expectedRow {
+			break
+		}
+		lines = append([]string{strings.TrimPrefix(prev.Content(content), "#")}, lines...)
+		expectedRow = prev.StartPoint().Row
+	}
+
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
@@ -0,0 +1,121 @@
+package toml
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+	"github.com/roveo/topo-mcp/languages/languagetest"
+)
+
+func TestLanguageMetadata(t *testing.T) {
+	lang := &Language{}
+
+	if lang.Name() != "toml" {
+		t.Errorf("expected name 'toml', got %q", lang.Name())
+	}
+
+	exts := lang.Extensions()
+	if len(exts) != 1 || exts[0] != ".toml" {
+		t.Errorf("expected extensions [.toml], got %v", exts)
+	}
+}
+
+func TestParsePyproject(t *testing.T) {
+	src := `requires-python = ">=3.11"
+
+# Package metadata
+[project]
+name = "app"
+dependencies = [
+  "requests",
+]
+urls.homepage = "https://example.com"
+
+# Test runner settings
+[tool.pytest.ini_options]
+testpaths = ["tests"]
+markers = { slow = "slow tests" }
+
+[tool."ruff.lint"]
+select = ["E"]
+`
+	lang := &Language{}
+	imports, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(imports) != 0 {
+		t.Errorf("expected no imports, got %v", imports)
+	}
+
+	tests := []struct {
+		kind    string
+		name    string
+		str     string
+		doc     string
+		members []string
+	}{
+		{"key", "requires-python", `requires-python = ">=3.11"`, "", nil},
+		{"table", "project", "[project]", "Package metadata", []string{`name = "app"`, "dependencies", `urls.homepage = "https://example.com"`}},
+		{"table", "tool.pytest.ini_options", "[tool.pytest.ini_options]", "Test runner settings", []string{"testpaths", "markers"}},
+		{"table", "tool.ruff.lint", "[tool.ruff.lint]", "", []string{"select"}},
+	}
+
+	if len(symbols) != len(tests) {
+		t.Fatalf("expected %d symbols, got %d", len(tests), len(symbols))
+	}
+	for i, tt := range tests {
+		sym := symbols[i]
+		if sym.Kind() != tt.kind || sym.Name() != tt.name {
+			t.Errorf("expected %s %q, got %s %q", tt.kind, tt.name, sym.Kind(), sym.Name())
+		}
+		if sym.String() != tt.str {
+			t.Errorf("unexpected String(): got %q, want %q", sym.String(), tt.str)
+		}
+		if doc := sym.(languages.Documented).DocComment(); doc != tt.doc {
+			t.Errorf("%s: expected doc comment %q, got %q", sym.Name(), tt.doc, doc)
+		}
+		members := languagetest.ChildStrings(sym)
+		if strings.Join(members, "|") != strings.Join(tt.members, "|") {
+			t.Errorf("%s: expected members %v, got %v", sym.Name(), tt.members, members)
+		}
+	}
+
+	// Comments before the next table are not part of the table
+	if loc := symbols[1].Location(); loc.Start.Line != 3 || loc.End.Line != 8 {
+		t.Errorf("expected project on lines 3-8, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+
+	found := languages.Lookup(symbols, "tool.pytest.ini_options.markers.slow")
+	if len(found) != 1 || found[0].String() != `slow = "slow tests"` {
+		t.Errorf("expected to find inline table key markers.slow, got %v", found)
+	}
+}
+
+func TestParseArrayOfTables(t *testing.T) {
+	src := `[[bin]]
+name = "cli"
+
+[[bin]]
+name = "server"
+path = "src/server.rs"
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 2 {
+		t.Fatalf("expected 2 symbols, got %d", len(symbols))
+	}
+	for _, sym := range symbols {
+		if sym.Kind() != "array_table" || sym.String() != "[[bin]]" {
+			t.Errorf("expected array table [[bin]], got %s %q", sym.Kind(), sym.String())
+		}
+	}
+	if loc := symbols[1].Location(); loc.Start.Line != 3 || loc.End.Line != 5 {
+		t.Errorf("expected second bin on lines 3-5, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 // Function represents a JS/TS function declaration
 type Function struct {
+	languages.Nesting
 	name      string
 	signature string
 	isAsync   bool
@@ -30,8 +31,10 @@ func (f *Function) String() string {
 }
 func (f *Function) DocComment() string { return f.doc }
 
-// Class represents a JS/TS class declaration
+// Class represents a JS/TS class declaration.
+// Methods, accessors and fields are its children.
 type Class struct {
+	languages.Nesting
 	name       string
 	extends    string
 	implements []string
@@ -58,8 +61,10 @@ func (c *Class) String() string {
 }
 func (c *Class) DocComment() string { return c.doc }
 
-// Interface represents a TypeScript interface declaration
+// Interface represents a TypeScript interface declaration.
+// Property and method signatures are its children.
 type Interface struct {
+	languages.Nesting
 	name string
 	doc  string
 	loc  languages.Range
@@ -73,6 +78,7 @@ func (i *Interface) DocComment() string        { return i.doc }
 
 // TypeAlias represents a TypeScript type alias declaration
 type TypeAlias struct {
+	languages.Nesting
 	name string
 	doc  string
 	loc  languages.Range
@@ -86,6 +92,7 @@ func (t *TypeAlias) DocComment() string        { return t.doc }
 
 // Enum represents a TypeScript enum declaration
 type Enum struct {
+	languages.Nesting
 	name string
 	doc  string
 	loc  languages.Range
@@ -97,8 +104,10 @@ func (e *Enum) Location() languages.Range { return e.loc }
 func (e *Enum) String() string            { return "enum " + e.name }
 func (e *Enum) DocComment() string        { return e.doc }
 
-// Variable represents a JS/TS variable declaration
+// Variable represents a JS/TS variable declaration.
+// Methods of an object literal value are its children.
 type Variable struct {
+	languages.Nesting
 	name string
 	kind string // "const", "let", "var"
 	loc  languages.Range
@@ -108,3 +117,71 @@ func (v *Variable) Name() string              { return v.name }
 func (v *Variable) Kind() string              { return v.kind }
 func (v *Variable) Location() languages.Range { return v.loc }
 func (v *Variable) String() string            { return v.kind + " " + v.name }
+
+// Method represents a method, accessor or method signature of a class,
+// interface or object literal
+type Method struct {
+	languages.Nesting
+	name      string
+	signature string
+	modifiers []string // e.g., "private", "static", "async", "get"
+	doc       string
+	loc       languages.Range
+}
+
+func (m *Method) Name() string { return m.name }
+func (m *Method) Kind() string {
+	if m.name == "constructor" {
+		return "constructor"
+	}
+	for _, mod := range m.modifiers {
+		switch mod {
+		case "get":
+			return "getter"
+		case "set":
+			return "setter"
+		}
+	}
+	return "method"
+}
+func (m *Method) Location() languages.Range { return m.loc }
+func (m *Method) String() string {
+	var sb strings.Builder
+	for _, mod := range m.modifiers {
+		sb.WriteString(mod)
+		sb.WriteString(" ")
+	}
+	sb.WriteString(m.name)
+	sb.WriteString(m.signature)
+	return sb.String()
+}
+func (m *Method) DocComment() string { return m.doc }
+
+// Property represents a class field or interface property signature
+type Property struct {
+	languages.Nesting
+	name      string
+	typeStr   string // Type annotation including the colon (e.g., ": number")
+	optional  bool
+	modifiers []string
+	doc       string
+	loc       languages.Range
+}
+
+func (p *Property) Name() string              { return p.name }
+func (p *Property) Kind() string              { return "property" }
+func (p *Property) Location() languages.Range { return p.loc }
+func (p *Property) String() string {
+	var sb strings.Builder
+	for _, mod := range p.modifiers {
+		sb.WriteString(mod)
+		sb.WriteString(" ")
+	}
+	sb.WriteString(p.name)
+	if p.optional {
+		sb.WriteString("?")
+	}
+	sb.WriteString(p.typeStr)
+	return sb.String()
+}
+func (p *Property) DocComment() string { return p.doc }
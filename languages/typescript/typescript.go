@@ -22,8 +22,9 @@ func init() {
 // TSLanguage implements TypeScript (.ts) parsing
 type TSLanguage struct{}
 
-func (t *TSLanguage) Name() string         { return "typescript" }
-func (t *TSLanguage) Extensions() []string { return []string{".ts"} }
+func (t *TSLanguage) Name() string           { return "typescript" }
+func (t *TSLanguage) Extensions() []string   { return []string{".ts"} }
+func (t *TSLanguage) Interpreters() []string { return []string{"ts-node", "deno"} }
 func (t *TSLanguage) Parse(content []byte) ([]string, []languages.Symbol, error) {
 	return parse(content, typescript.GetLanguage(), "typescript")
 }
@@ -40,8 +41,9 @@ func (t *TSXLanguage) Parse(content []byte) ([]string, []languages.Symbol, error
 // JSLanguage implements JavaScript (.js) parsing
 type JSLanguage struct{}
 
-func (j *JSLanguage) Name() string         { return "javascript" }
-func (j *JSLanguage) Extensions() []string { return []string{".js", ".mjs", ".cjs"} }
+func (j *JSLanguage) Name() string           { return "javascript" }
+func (j *JSLanguage) Extensions() []string   { return []string{".js", ".mjs", ".cjs"} }
+func (j *JSLanguage) Interpreters() []string { return []string{"node", "nodejs"} }
 func (j *JSLanguage) Parse(content []byte) ([]string, []languages.Symbol, error) {
 	return parse(content, javascript.GetLanguage(), "javascript")
 }
@@ -118,9 +120,10 @@ func extractFunction(node *sitter.Node, content []byte) languages.Symbol {
 		name = nameNode.Content(content)
 	}
 
+	typeParams := node.ChildByFieldName("type_parameters")
 	params := node.ChildByFieldName("parameters")
 	returnType := node.ChildByFieldName("return_type")
-	signature := formatSignature(params, returnType, content)
+	signature := formatSignature(typeParams, params, returnType, content)
 
 	isAsync := hasChildOfType(node, "async")
 	doc := extractDoc(node, content)
@@ -153,13 +156,188 @@ func extractClass(node *sitter.Node, content []byte) languages.Symbol {
 
 	doc := extractDoc(node, content)
 
-	return &Class{
+	cls := &Class{
 		name:       name,
 		extends:    extends,
 		implements: implements,
 		doc:        doc,
 		loc:        languages.NodeRange(node),
 	}
+
+	body := node.ChildByFieldName("body")
+	if body != nil {
+		for _, member := range extractClassMembers(body, content) {
+			languages.AddChild(cls, member)
+		}
+	}
+
+	return cls
+}
+
+// extractClassMembers extracts methods, accessors and fields from a class body
+func extractClassMembers(body *sitter.Node, content []byte) []languages.Symbol {
+	var members []languages.Symbol
+
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		child := body.NamedChild(i)
+		switch child.Type() {
+		case "method_definition", "abstract_method_signature":
+			members = append(members, extractMethod(child, content))
+		case "public_field_definition", "field_definition":
+			members = append(members, extractField(child, content))
+		}
+	}
+
+	return members
+}
+
+// extractMethod extracts a method, accessor or method signature
+func extractMethod(node *sitter.Node, content []byte) languages.Symbol {
+	nameNode := node.ChildByFieldName("name")
+	name := ""
+	if nameNode != nil {
+		name = nameNode.Content(content)
+	}
+
+	typeParams := node.ChildByFieldName("type_parameters")
+	params := node.ChildByFieldName("parameters")
+	returnType := node.ChildByFieldName("return_type")
+	signature := formatSignature(typeParams, params, returnType, content)
+
+	doc := extractDoc(node, content)
+
+	return &Method{
+		name:      name,
+		signature: signature,
+		modifiers: extractModifiers(node, content),
+		doc:       doc,
+		loc:       languages.NodeRange(node),
+	}
+}
+
+// extractField extracts a class field. Fields holding arrow functions or
+// function expressions are treated as methods.
+func extractField(node *sitter.Node, content []byte) languages.Symbol {
+	nameNode := node.ChildByFieldName("name")
+	if nameNode == nil {
+		// JavaScript field_definition uses "property" instead of "name"
+		nameNode = node.ChildByFieldName("property")
+	}
+	name := ""
+	if nameNode != nil {
+		name = nameNode.Content(content)
+	}
+
+	modifiers := extractModifiers(node, content)
+	doc := extractDoc(node, content)
+
+	if value := node.ChildByFieldName("value"); isFunctionValue(value) {
+		return &Method{
+			name:      name,
+			signature: formatFunctionValueSignature(value, content),
+			modifiers: append(modifiers, extractModifiers(value, content)...),
+			doc:       doc,
+			loc:       languages.NodeRange(node),
+		}
+	}
+
+	typeStr := ""
+	if typeNode := node.ChildByFieldName("type"); typeNode != nil {
+		typeStr = typeNode.Content(content)
+	}
+
+	return &Property{
+		name:      name,
+		typeStr:   typeStr,
+		optional:  hasChildOfType(node, "?"),
+		modifiers: modifiers,
+		doc:       doc,
+		loc:       languages.NodeRange(node),
+	}
+}
+
+// extractObjectMembers extracts methods and function-valued properties from an object literal
+func extractObjectMembers(node *sitter.Node, content []byte) []languages.Symbol {
+	var members []languages.Symbol
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "method_definition":
+			members = append(members, extractMethod(child, content))
+		case "pair":
+			value := child.ChildByFieldName("value")
+			if !isFunctionValue(value) {
+				continue
+			}
+			name := ""
+			if key := child.ChildByFieldName("key"); key != nil {
+				name = strings.Trim(key.Content(content), `"'`)
+			}
+			members = append(members, &Method{
+				name:      name,
+				signature: formatFunctionValueSignature(value, content),
+				modifiers: extractModifiers(value, content),
+				doc:       extractDoc(child, content),
+				loc:       languages.NodeRange(child),
+			})
+		}
+	}
+
+	return members
+}
+
+// isFunctionValue reports whether a node is an arrow function or function expression
+func isFunctionValue(node *sitter.Node) bool {
+	if node == nil {
+		return false
+	}
+	switch node.Type() {
+	case "arrow_function", "function_expression", "function":
+		return true
+	}
+	return false
+}
+
+// formatFunctionValueSignature formats the signature of an arrow function or function expression
+func formatFunctionValueSignature(node *sitter.Node, content []byte) string {
+	if param := node.ChildByFieldName("parameter"); param != nil {
+		// Arrow function with a single unparenthesized parameter
+		return "(" + param.Content(content) + ")"
+	}
+	typeParams := node.ChildByFieldName("type_parameters")
+	params := node.ChildByFieldName("parameters")
+	returnType := node.ChildByFieldName("return_type")
+	return formatSignature(typeParams, params, returnType, content)
+}
+
+// memberModifiers are the keywords that may precede a class or interface member name
+var memberModifiers = map[string]bool{
+	"static":   true,
+	"async":    true,
+	"get":      true,
+	"set":      true,
+	"readonly": true,
+	"abstract": true,
+	"override": true,
+	"declare":  true,
+}
+
+// extractModifiers collects accessibility and keyword modifiers of a member
+func extractModifiers(node *sitter.Node, content []byte) []string {
+	var modifiers []string
+
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		switch {
+		case child.Type() == "accessibility_modifier":
+			modifiers = append(modifiers, child.Content(content))
+		case !child.IsNamed() && memberModifiers[child.Type()]:
+			modifiers = append(modifiers, child.Type())
+		}
+	}
+
+	return modifiers
 }
 
 func extractHeritage(node *sitter.Node, content []byte) (string, []string) {
@@ -193,11 +371,37 @@ func extractInterface(node *sitter.Node, content []byte) languages.Symbol {
 
 	doc := extractDoc(node, content)
 
-	return &Interface{
+	iface := &Interface{
 		name: name,
 		doc:  doc,
 		loc:  languages.NodeRange(node),
 	}
+
+	body := node.ChildByFieldName("body")
+	if body != nil {
+		for _, member := range extractInterfaceMembers(body, content) {
+			languages.AddChild(iface, member)
+		}
+	}
+
+	return iface
+}
+
+// extractInterfaceMembers extracts named property and method signatures from an interface body
+func extractInterfaceMembers(body *sitter.Node, content []byte) []languages.Symbol {
+	var members []languages.Symbol
+
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		child := body.NamedChild(i)
+		switch child.Type() {
+		case "method_signature":
+			members = append(members, extractMethod(child, content))
+		case "property_signature":
+			members = append(members, extractField(child, content))
+		}
+	}
+
+	return members
 }
 
 func extractTypeAlias(node *sitter.Node, content []byte) languages.Symbol {
@@ -252,20 +456,65 @@ func extractVariables(node *sitter.Node, content []byte) []languages.Symbol {
 		child := node.NamedChild(i)
 		if child.Type() == "variable_declarator" {
 			nameNode := child.ChildByFieldName("name")
-			if nameNode != nil {
-				name := nameNode.Content(content)
-				symbols = append(symbols, &Variable{
-					name: name,
-					kind: kind,
-					loc:  languages.NodeRange(child),
-				})
+			if nameNode == nil {
+				continue
 			}
+			if nameNode.Type() == "object_pattern" || nameNode.Type() == "array_pattern" {
+				// "const { a, b: c } = obj" declares a and c
+				for _, name := range patternNames(nameNode, content) {
+					symbols = append(symbols, &Variable{
+						name: name,
+						kind: kind,
+						loc:  languages.NodeRange(child),
+					})
+				}
+				continue
+			}
+			v := &Variable{
+				name: nameNode.Content(content),
+				kind: kind,
+				loc:  languages.NodeRange(child),
+			}
+
+			// Object literals like service objects expose their methods
+			if value := child.ChildByFieldName("value"); value != nil && value.Type() == "object" {
+				for _, member := range extractObjectMembers(value, content) {
+					languages.AddChild(v, member)
+				}
+			}
+
+			symbols = append(symbols, v)
 		}
 	}
 
 	return symbols
 }
 
+// patternNames returns the names bound by a destructuring pattern, in order
+// (e.g., "a", "c" and "rest" for { a, b: c, ...rest } or [a, c, ...rest])
+func patternNames(node *sitter.Node, content []byte) []string {
+	switch node.Type() {
+	case "identifier", "shorthand_property_identifier_pattern":
+		return []string{node.Content(content)}
+	case "pair_pattern":
+		if value := node.ChildByFieldName("value"); value != nil {
+			return patternNames(value, content)
+		}
+		return nil
+	case "object_assignment_pattern", "assignment_pattern":
+		if left := node.ChildByFieldName("left"); left != nil {
+			return patternNames(left, content)
+		}
+		return nil
+	}
+	// Object, array and rest patterns
+	var names []string
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		names = append(names, patternNames(node.NamedChild(i), content)...)
+	}
+	return names
+}
+
 func extractExport(node *sitter.Node, content []byte) ([]languages.Symbol, []string) {
 	var symbols []languages.Symbol
 	var imports []string
@@ -291,9 +540,14 @@ func extractExport(node *sitter.Node, content []byte) ([]languages.Symbol, []str
 	return symbols, imports
 }
 
-func formatSignature(params, returnType *sitter.Node, content []byte) string {
+// formatSignature formats generic type parameters, parameters and return type
+// (e.g., "<T>(key: string): T")
+func formatSignature(typeParams, params, returnType *sitter.Node, content []byte) string {
 	var sb strings.Builder
 
+	if typeParams != nil {
+		sb.WriteString(typeParams.Content(content))
+	}
 	if params != nil {
 		sb.WriteString(params.Content(content))
 	} else {
@@ -3,6 +3,8 @@ package typescript
 import (
 	"strings"
 	"testing"
+
+	"github.com/roveo/topo-mcp/languages/languagetest"
 )
 
 func TestLanguageMetadata(t *testing.T) {
@@ -291,6 +293,26 @@ var legacy = "old";
 	}
 }
 
+func TestParseDestructuredVariables(t *testing.T) {
+	src := `const { host, port: listenPort = 80, ...options } = config;
+let [first, , [second]] = pairs;
+`
+	lang := &TSLanguage{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	var got []string
+	for _, sym := range symbols {
+		got = append(got, sym.String())
+	}
+	expected := []string{"const host", "const listenPort", "const options", "let first", "let second"}
+	if strings.Join(got, "|") != strings.Join(expected, "|") {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
 func TestParseImports(t *testing.T) {
 	src := `import { useState } from 'react';
 import express from 'express';
@@ -434,6 +456,11 @@ func TestParseFunctionSignatures(t *testing.T) {
 			src:     `function f(...args: string[]) {}`,
 			wantSig: "function f(...args: string[])",
 		},
+		{
+			name:    "type params",
+			src:     `function f<T extends object>(x: T): T { return x; }`,
+			wantSig: "function f<T extends object>(x: T): T",
+		},
 	}
 
 	for _, tt := range tests {
@@ -454,3 +481,116 @@ func TestParseFunctionSignatures(t *testing.T) {
 		})
 	}
 }
+
+func TestParseClassMembers(t *testing.T) {
+	src := `class Server {
+  private port: number = 80;
+  handler = async (req: Request): Promise<void> => {};
+  constructor(port: number) {}
+  /** Start listening */
+  async start(): Promise<void> {}
+  get address(): string { return ""; }
+  set address(v: string) {}
+  static create(): Server { return new Server(1); }
+  map<U>(fn: (s: Server) => U): U { return fn(this); }
+}
+`
+	lang := &TSLanguage{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 1 {
+		t.Fatalf("expected 1 symbol, got %d", len(symbols))
+	}
+
+	cls, ok := symbols[0].(*Class)
+	if !ok {
+		t.Fatalf("expected *Class, got %T", symbols[0])
+	}
+
+	expected := []string{
+		"private port: number",
+		"async handler(req: Request): Promise<void>",
+		"constructor(port: number)",
+		"async start(): Promise<void>",
+		"get address(): string",
+		"set address(v: string)",
+		"static create(): Server",
+		"map<U>(fn: (s: Server) => U): U",
+	}
+	got := languagetest.ChildStrings(cls)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected members:\ngot:  %q\nwant: %q", got, expected)
+	}
+
+	kinds := []string{"property", "method", "constructor", "method", "getter", "setter", "method", "method"}
+	for i, m := range cls.Children() {
+		if m.Kind() != kinds[i] {
+			t.Errorf("member %q: expected kind %q, got %q", m.Name(), kinds[i], m.Kind())
+		}
+	}
+
+	start := cls.Children()[3].(*Method)
+	if start.DocComment() != "Start listening" {
+		t.Errorf("expected doc comment 'Start listening', got %q", start.DocComment())
+	}
+	if loc := start.Location(); loc.Start.Line != 5 {
+		t.Errorf("expected start() on line 5, got %d", loc.Start.Line)
+	}
+}
+
+func TestParseInterfaceMembers(t *testing.T) {
+	src := `interface Config {
+  name: string;
+  readonly port?: number;
+  handle(req: Request): void;
+  get<T>(key: string): T;
+  [key: string]: any;
+}
+`
+	lang := &TSLanguage{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	iface, ok := symbols[0].(*Interface)
+	if !ok {
+		t.Fatalf("expected *Interface, got %T", symbols[0])
+	}
+
+	expected := []string{"name: string", "readonly port?: number", "handle(req: Request): void", "get<T>(key: string): T"}
+	got := languagetest.ChildStrings(iface)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected members:\ngot:  %q\nwant: %q", got, expected)
+	}
+}
+
+func TestParseObjectLiteralExport(t *testing.T) {
+	src := `export const api = {
+  get(url) { return fetch(url); },
+  post: async (url, body) => {},
+  del: url => {},
+  baseURL: "/api",
+};
+`
+	lang := &JSLanguage{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	v, ok := symbols[0].(*Variable)
+	if !ok {
+		t.Fatalf("expected *Variable, got %T", symbols[0])
+	}
+
+	// Only function-valued members are indexed
+	expected := []string{"get(url)", "async post(url, body)", "del(url)"}
+	got := languagetest.ChildStrings(v)
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected members:\ngot:  %q\nwant: %q", got, expected)
+	}
+}
@@ -1,6 +1,8 @@
 package languages
 
 import (
+	"strings"
+
 	sitter "github.com/smacker/go-tree-sitter"
 )
 
@@ -13,3 +15,26 @@ func NodeRange(node *sitter.Node) Range {
 		End:   Position{Line: int(end.Row), Character: int(end.Column)},
 	}
 }
+
+// FieldContent returns the content of a node's field, or "" if it is missing
+func FieldContent(node *sitter.Node, field string, content []byte) string {
+	if child := node.ChildByFieldName(field); child != nil {
+		return child.Content(content)
+	}
+	return ""
+}
+
+// FirstChildOfType returns the first named child of the given type, or nil
+func FirstChildOfType(node *sitter.Node, typ string) *sitter.Node {
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if child := node.NamedChild(i); child.Type() == typ {
+			return child
+		}
+	}
+	return nil
+}
+
+// Compact collapses runs of whitespace, so multi-line declarations render on one line
+func Compact(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
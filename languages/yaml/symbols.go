@@ -0,0 +1,43 @@
+package yaml
+
+import (
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Key represents a mapping key with its value. Keys of a nested mapping are its children.
+type Key struct {
+	languages.Nesting
+	name  string
+	kind  string // "object", "array" or "key" (for scalar values)
+	value string // Short scalar value as written (e.g., `"nginx:1.25"`), if any
+	doc   string
+	loc   languages.Range
+}
+
+func (k *Key) Name() string              { return k.name }
+func (k *Key) Kind() string              { return k.kind }
+func (k *Key) Location() languages.Range { return k.loc }
+func (k *Key) DocComment() string        { return k.doc }
+
+// String returns the key, followed by its value if it is a short scalar
+func (k *Key) String() string {
+	if k.value == "" {
+		return k.name
+	}
+	return k.name + ": " + k.value
+}
+
+// Document represents a Kubernetes-style document of a YAML stream, named
+// "<kind>/<metadata.name>" (e.g., "Deployment/api"). Its keys are its children.
+type Document struct {
+	languages.Nesting
+	name string
+	doc  string
+	loc  languages.Range
+}
+
+func (d *Document) Name() string              { return d.name }
+func (d *Document) Kind() string              { return "document" }
+func (d *Document) Location() languages.Range { return d.loc }
+func (d *Document) String() string            { return d.name }
+func (d *Document) DocComment() string        { return d.doc }
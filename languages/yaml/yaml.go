@@ -0,0 +1,307 @@
+package yaml
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/yaml"
+)
+
+func init() {
+	languages.Register(&Language{})
+	languages.Register(&JSONLanguage{})
+}
+
+// Language implements YAML parsing. Keys are indexed as a symbol tree.
+type Language struct{}
+
+func (l *Language) Name() string         { return "yaml" }
+func (l *Language) Extensions() []string { return []string{".yaml", ".yml"} }
+
+func (l *Language) TreeSitterLang() *sitter.Language {
+	return yaml.GetLanguage()
+}
+
+func (l *Language) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	return parse(content, "YAML")
+}
+
+// JSONLanguage implements JSON parsing. JSON is a subset of YAML, so it is
+// parsed with the YAML grammar, once the comments allowed in JSONC files
+// (e.g., tsconfig.json) are blanked out.
+type JSONLanguage struct{}
+
+func (l *JSONLanguage) Name() string         { return "json" }
+func (l *JSONLanguage) Extensions() []string { return []string{".json"} }
+
+func (l *JSONLanguage) TreeSitterLang() *sitter.Language {
+	return yaml.GetLanguage()
+}
+
+func (l *JSONLanguage) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	return parse(blankComments(content), "JSON")
+}
+
+// blankComments returns a copy of JSON content with // and /* */ comments
+// replaced by spaces. Line breaks are kept, so positions in the copy are the
+// same as in content.
+func blankComments(content []byte) []byte {
+	blanked := bytes.Clone(content)
+	inString := false
+	for i := 0; i < len(blanked); i++ {
+		switch {
+		case inString:
+			if blanked[i] == '\\' {
+				i++
+			} else if blanked[i] == '"' {
+				inString = false
+			}
+		case blanked[i] == '"':
+			inString = true
+		case bytes.HasPrefix(blanked[i:], []byte("//")):
+			for ; i < len(blanked) && blanked[i] != '\n'; i++ {
+				blanked[i] = ' '
+			}
+		case bytes.HasPrefix(blanked[i:], []byte("/*")):
+			end := bytes.Index(blanked[i+2:], []byte("*/"))
+			if end == -1 {
+				end = len(blanked) - i - 4
+			}
+			for j := i; j < i+end+4; j++ {
+				if blanked[j] != '\n' {
+					blanked[j] = ' '
+				}
+			}
+			i += end + 3
+		}
+	}
+	return blanked
+}
+
+// maxValueLen is the longest scalar value shown in a key's String()
+const maxValueLen = 60
+
+func parse(content []byte, langName string) ([]string, []languages.Symbol, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(yaml.GetLanguage())
+
+	tree, err := parser.ParseCtx(context.Background(), nil, content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse %s file: %w", langName, err)
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+
+	var symbols []languages.Symbol
+
+	for i := 0; i < int(root.NamedChildCount()); i++ {
+		document := root.NamedChild(i)
+		if document.Type() != "document" {
+			continue
+		}
+		body := firstContentChild(document)
+		mapping := valueOf(body)
+		if mapping == nil || !isMapping(mapping) {
+			continue
+		}
+		keys := extractMapping(mapping, content)
+
+		// Kubernetes-style documents are wrapped in a symbol named by their
+		// kind and name; keys of other documents are top-level symbols
+		name := documentName(keys)
+		if name == "" {
+			symbols = append(symbols, keys...)
+			continue
+		}
+		// A comment above the first key describes the whole document
+		first := keys[0].(*Key)
+		// The document starts at its content, not at the "---" marker
+		doc := &Document{
+			name: name,
+			doc:  first.doc,
+			loc:  trimmedRange(document, content),
+		}
+		doc.loc.Start = languages.NodeRange(body).Start
+		first.doc = ""
+		for _, key := range keys {
+			languages.AddChild(doc, key)
+		}
+		symbols = append(symbols, doc)
+	}
+
+	return nil, symbols, nil
+}
+
+// extractMapping extracts the keys of a block or flow mapping
+func extractMapping(mapping *sitter.Node, content []byte) []languages.Symbol {
+	var keys []languages.Symbol
+	for i := 0; i < int(mapping.NamedChildCount()); i++ {
+		pair := mapping.NamedChild(i)
+		if pair.Type() != "block_mapping_pair" && pair.Type() != "flow_pair" {
+			continue
+		}
+		keyNode := valueOf(pair.ChildByFieldName("key"))
+		if keyNode == nil {
+			continue
+		}
+
+		key := &Key{
+			name: scalarValue(keyNode, content),
+			kind: "key",
+			doc:  extractDoc(pair, content),
+			loc:  trimmedRange(pair, content),
+		}
+		value := valueOf(pair.ChildByFieldName("value"))
+		switch {
+		case value == nil:
+		case isMapping(value):
+			key.kind = "object"
+			for _, child := range extractMapping(value, content) {
+				languages.AddChild(key, child)
+			}
+		case value.Type() == "block_sequence" || value.Type() == "flow_sequence":
+			key.kind = "array"
+		default:
+			if text := value.Content(content); len(text) <= maxValueLen && !strings.Contains(text, "\n") {
+				key.value = text
+			}
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// documentName returns "<kind>/<metadata.name>" for a document with both, or ""
+func documentName(keys []languages.Symbol) string {
+	var kind, name string
+	for _, key := range keys {
+		switch key.Name() {
+		case "kind":
+			kind = unquote(key.(*Key).value)
+		case "metadata":
+			for _, child := range languages.ChildrenOf(key) {
+				if child.Name() == "name" {
+					name = unquote(child.(*Key).value)
+				}
+			}
+		}
+	}
+	if kind == "" || name == "" {
+		return ""
+	}
+	return kind + "/" + name
+}
+
+// valueOf unwraps a block or flow node to the mapping, sequence or scalar it
+// holds, skipping anchors and tags. Returns nil for a missing node.
+func valueOf(node *sitter.Node) *sitter.Node {
+	for node != nil && (node.Type() == "block_node" || node.Type() == "flow_node") {
+		node = firstContentChild(node)
+	}
+	return node
+}
+
+// firstContentChild returns the first named child that is not a comment, anchor or tag
+func firstContentChild(node *sitter.Node) *sitter.Node {
+	if node == nil {
+		return nil
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		switch child := node.NamedChild(i); child.Type() {
+		case "comment", "anchor", "tag":
+		default:
+			return child
+		}
+	}
+	return nil
+}
+
+// isMapping reports whether a node is a block or flow mapping
+func isMapping(node *sitter.Node) bool {
+	return node.Type() == "block_mapping" || node.Type() == "flow_mapping"
+}
+
+// scalarValue returns the value of a scalar without quotes
+func scalarValue(node *sitter.Node, content []byte) string {
+	return unquote(node.Content(content))
+}
+
+// unquote strips the quotes of a double- or single-quoted scalar
+func unquote(s string) string {
+	if len(s) < 2 {
+		return s
+	}
+	switch s[0] {
+	case '"':
+		if unquoted, err := strconv.Unquote(s); err == nil {
+			return unquoted
+		}
+		return strings.Trim(s, `"`)
+	case '\'':
+		if s[len(s)-1] == '\'' {
+			return strings.ReplaceAll(s[1:len(s)-1], "''", "'")
+		}
+	}
+	return s
+}
+
+// trimmedRange returns the range of a node without trailing comments and
+// whitespace. Block nodes extend over comments up to the next key.
+func trimmedRange(node *sitter.Node, content []byte) languages.Range {
+	r := languages.NodeRange(node)
+	text := bytes.TrimRight(content[node.StartByte():lastToken(node).EndByte()], " \t\r\n")
+	r.End.Line = r.Start.Line + bytes.Count(text, []byte("\n"))
+	if idx := bytes.LastIndexByte(text, '\n'); idx != -1 {
+		r.End.Character = len(text) - idx - 1
+	} else {
+		r.End.Character = r.Start.Character + len(text)
+	}
+	return r
+}
+
+// lastToken returns the last descendant of a node that is not a comment
+func lastToken(node *sitter.Node) *sitter.Node {
+	for i := int(node.ChildCount()) - 1; i >= 0; i-- {
+		if child := node.Child(i); child.Type() != "comment" {
+			return lastToken(child)
+		}
+	}
+	return node
+}
+
+// extractDoc extracts the first line of the # comments directly preceding a
+// key or document. Comments before the first key of a mapping belong to the
+// first key, even though the grammar places them before the mapping.
+func extractDoc(node *sitter.Node, content []byte) string {
+	prev := node.PrevNamedSibling()
+	for ancestor := node.Parent(); prev == nil && ancestor != nil; ancestor = ancestor.Parent() {
+		if ancestor.Type() == "block_mapping_pair" || ancestor.Type() == "flow_pair" {
+			break
+		}
+		prev = ancestor.PrevNamedSibling()
+	}
+
+	var lines []string
+	expectedRow := node.StartPoint().Row
+	for ; prev != nil && prev.Type() == "comment"; prev = prev.PrevNamedSibling() {
+		if prev.EndPoint().Row+1 != expectedRow {
+			break
+		}
+		lines = append([]string{strings.TrimPrefix(prev.Content(content), "#")}, lines...)
+		expectedRow = prev.StartPoint().Row
+	}
+
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
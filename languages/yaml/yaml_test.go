@@ -0,0 +1,234 @@
+package yaml
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+	"github.com/roveo/topo-mcp/languages/languagetest"
+)
+
+func TestLanguageMetadata(t *testing.T) {
+	y := &Language{}
+	if y.Name() != "yaml" {
+		t.Errorf("expected name 'yaml', got %q", y.Name())
+	}
+	if exts := strings.Join(y.Extensions(), ","); exts != ".yaml,.yml" {
+		t.Errorf("expected extensions [.yaml .yml], got %v", y.Extensions())
+	}
+
+	j := &JSONLanguage{}
+	if j.Name() != "json" {
+		t.Errorf("expected name 'json', got %q", j.Name())
+	}
+	if exts := j.Extensions(); len(exts) != 1 || exts[0] != ".json" {
+		t.Errorf("expected extensions [.json], got %v", exts)
+	}
+}
+
+func TestParseCompose(t *testing.T) {
+	src := `# Local development stack
+services:
+  api:
+    image: "nginx:1.25"
+    # Settings for the API
+    environment:
+      - LOG_LEVEL=debug
+    ports: [80]
+  db: {image: postgres, 'restart': always}
+# Named volumes
+
+volumes:
+  data: &data
+    driver: local
+`
+	lang := &Language{}
+	imports, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(imports) != 0 {
+		t.Errorf("expected no imports, got %v", imports)
+	}
+
+	if len(symbols) != 2 {
+		t.Fatalf("expected 2 symbols, got %d", len(symbols))
+	}
+	services := symbols[0].(*Key)
+	if services.Kind() != "object" || services.DocComment() != "Local development stack" {
+		t.Errorf("expected documented object services, got %s with doc %q", services.Kind(), services.DocComment())
+	}
+	// Trailing comments belong to the next key, not to the mapping before them
+	if loc := services.Location(); loc.Start.Line != 1 || loc.End.Line != 8 {
+		t.Errorf("expected services on lines 1-8, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+
+	tests := []struct {
+		selector string
+		kind     string
+		str      string
+		members  []string
+	}{
+		{"services.api", "object", "api", []string{`image: "nginx:1.25"`, "environment", "ports"}},
+		{"services.api.environment", "array", "environment", nil},
+		{"services.db", "object", "db", []string{"image: postgres", "restart: always"}},
+		{"volumes.data", "object", "data", []string{"driver: local"}},
+	}
+	for _, tt := range tests {
+		found := languages.Lookup(symbols, tt.selector)
+		if len(found) != 1 {
+			t.Errorf("expected to find %s, got %d matches", tt.selector, len(found))
+			continue
+		}
+		key := found[0].(*Key)
+		if key.Kind() != tt.kind || key.String() != tt.str {
+			t.Errorf("%s: expected %s %q, got %s %q", tt.selector, tt.kind, tt.str, key.Kind(), key.String())
+		}
+		if members := languagetest.ChildStrings(key); strings.Join(members, "|") != strings.Join(tt.members, "|") {
+			t.Errorf("%s: expected members %v, got %v", tt.selector, tt.members, members)
+		}
+	}
+
+	env := languages.Lookup(symbols, "services.api.environment")[0].(*Key)
+	if env.DocComment() != "Settings for the API" {
+		t.Errorf("expected doc comment 'Settings for the API', got %q", env.DocComment())
+	}
+	if loc := env.Location(); loc.Start.Line != 5 || loc.End.Line != 6 {
+		t.Errorf("expected environment on lines 5-6, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+	if doc := symbols[1].(*Key).DocComment(); doc != "" {
+		t.Errorf("expected comment separated by a blank line not to document volumes, got %q", doc)
+	}
+}
+
+func TestParseKubernetesManifests(t *testing.T) {
+	src := `# API server
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: api
+spec:
+  replicas: 2
+---
+apiVersion: v1
+kind: Service
+metadata: {name: "api"}
+spec:
+  ports:
+    - port: 80
+`
+	lang := &Language{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(symbols) != 2 {
+		t.Fatalf("expected 2 documents, got %d", len(symbols))
+	}
+	tests := []struct {
+		name      string
+		startLine int
+		endLine   int
+	}{
+		{"Deployment/api", 1, 6},
+		{"Service/api", 8, 13},
+	}
+	for i, tt := range tests {
+		doc := symbols[i].(*Document)
+		if doc.Kind() != "document" || doc.Name() != tt.name {
+			t.Errorf("expected document %q, got %s %q", tt.name, doc.Kind(), doc.Name())
+		}
+		if loc := doc.Location(); loc.Start.Line != tt.startLine || loc.End.Line != tt.endLine {
+			t.Errorf("%s: expected lines %d-%d, got %d-%d", tt.name, tt.startLine, tt.endLine, loc.Start.Line, loc.End.Line)
+		}
+	}
+
+	// The comment above the first key documents the document
+	if doc := symbols[0].(*Document).DocComment(); doc != "API server" {
+		t.Errorf("expected doc comment 'API server', got %q", doc)
+	}
+	if doc := languages.ChildrenOf(symbols[0])[0].(*Key).DocComment(); doc != "" {
+		t.Errorf("expected apiVersion to be undocumented, got %q", doc)
+	}
+
+	if found := languages.Lookup(symbols, "Deployment/api.spec.replicas"); len(found) != 1 || found[0].String() != "replicas: 2" {
+		t.Errorf("expected to find Deployment/api.spec.replicas, got %v", found)
+	}
+	// Keys with the same path in different documents are told apart by the document
+	if found := languages.Lookup(symbols, "spec"); len(found) != 2 {
+		t.Errorf("expected 2 spec keys, got %d", len(found))
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	src := `{
+  "name": "app",
+  "scripts": {
+    "build": "tsc -p .",
+    "test": "vitest"
+  },
+  "files": ["dist"]
+}
+`
+	lang := &JSONLanguage{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	var strs []string
+	for _, sym := range symbols {
+		strs = append(strs, sym.String())
+	}
+	if got := strings.Join(strs, "|"); got != `name: "app"|scripts|files` {
+		t.Errorf("unexpected top-level keys: %v", strs)
+	}
+
+	found := languages.Lookup(symbols, "scripts.build")
+	if len(found) != 1 {
+		t.Fatalf("expected to find scripts.build, got %d matches", len(found))
+	}
+	if found[0].String() != `build: "tsc -p ."` {
+		t.Errorf("unexpected String(): %q", found[0].String())
+	}
+	if loc := found[0].Location(); loc.Start.Line != 3 || loc.End.Line != 3 {
+		t.Errorf("expected scripts.build on line 3, got %d-%d", loc.Start.Line, loc.End.Line)
+	}
+}
+
+func TestParseJSONWithComments(t *testing.T) {
+	src := `{
+  // Compiler options.
+  "compilerOptions": {
+    "strict": true, // No implicit any
+    /* Path aliases */ "paths": {
+      "@/*": ["src/*"]
+    },
+  },
+  "include": ["src"] /* Sources */
+}
+`
+	lang := &JSONLanguage{}
+	_, symbols, err := lang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	var names []string
+	languages.Walk(symbols, func(sym languages.Symbol, _ int) {
+		names = append(names, languages.QualifiedName(sym))
+	})
+	expected := "compilerOptions|compilerOptions.strict|compilerOptions.paths|compilerOptions.paths.@/*|include"
+	if got := strings.Join(names, "|"); got != expected {
+		t.Errorf("expected keys %s, got %s", expected, got)
+	}
+
+	found := languages.Lookup(symbols, "compilerOptions.paths")
+	if len(found) != 1 {
+		t.Fatalf("expected to find compilerOptions.paths, got %d matches", len(found))
+	}
+	if loc := found[0].Location(); loc.Start.Line != 4 || loc.Start.Character != 23 || loc.End.Line != 6 {
+		t.Errorf("expected compilerOptions.paths at 4:23-6, got %d:%d-%d", loc.Start.Line, loc.Start.Character, loc.End.Line)
+	}
+}
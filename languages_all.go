@@ -1,12 +1,31 @@
-//go:build !lang_go && !lang_python && !lang_typescript && !lang_rust && !lang_markdown
+//go:build !lang_go && !lang_python && !lang_typescript && !lang_rust && !lang_markdown && !lang_java && !lang_cpp && !lang_csharp && !lang_ruby && !lang_php && !lang_kotlin && !lang_swift && !lang_bash && !lang_sql && !lang_protobuf && !lang_hcl && !lang_yaml && !lang_toml && !lang_sfc && !lang_lua && !lang_elixir && !lang_scala && !lang_makefile && !lang_dockerfile
 
 package main
 
 // Import all language packages by default (when no lang_* tags specified)
 import (
+	_ "github.com/roveo/topo-mcp/languages/bash"
+	_ "github.com/roveo/topo-mcp/languages/cpp"
+	_ "github.com/roveo/topo-mcp/languages/csharp"
+	_ "github.com/roveo/topo-mcp/languages/dockerfile"
+	_ "github.com/roveo/topo-mcp/languages/elixir"
 	_ "github.com/roveo/topo-mcp/languages/golang"
+	_ "github.com/roveo/topo-mcp/languages/hcl"
+	_ "github.com/roveo/topo-mcp/languages/java"
+	_ "github.com/roveo/topo-mcp/languages/kotlin"
+	_ "github.com/roveo/topo-mcp/languages/lua"
+	_ "github.com/roveo/topo-mcp/languages/makefile"
 	_ "github.com/roveo/topo-mcp/languages/markdown"
+	_ "github.com/roveo/topo-mcp/languages/php"
+	_ "github.com/roveo/topo-mcp/languages/protobuf"
 	_ "github.com/roveo/topo-mcp/languages/python"
+	_ "github.com/roveo/topo-mcp/languages/ruby"
 	_ "github.com/roveo/topo-mcp/languages/rust"
+	_ "github.com/roveo/topo-mcp/languages/scala"
+	_ "github.com/roveo/topo-mcp/languages/sfc"
+	_ "github.com/roveo/topo-mcp/languages/sql"
+	_ "github.com/roveo/topo-mcp/languages/swift"
+	_ "github.com/roveo/topo-mcp/languages/toml"
 	_ "github.com/roveo/topo-mcp/languages/typescript"
+	_ "github.com/roveo/topo-mcp/languages/yaml"
 )
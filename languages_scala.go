@@ -0,0 +1,7 @@
+//go:build lang_scala
+
+package main
+
+import (
+	_ "github.com/roveo/topo-mcp/languages/scala"
+)
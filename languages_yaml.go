@@ -0,0 +1,7 @@
+//go:build lang_yaml
+
+package main
+
+import (
+	_ "github.com/roveo/topo-mcp/languages/yaml"
+)
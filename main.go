@@ -3,14 +3,17 @@ package main
 import (
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/roveo/topo-mcp/languages"
 	"github.com/roveo/topo-mcp/tools"
 	"github.com/spf13/cobra"
 )
 
 var (
-	skipPatterns []string
-	lineLimit    int
+	skipPatterns  []string
+	lineLimit     int
+	langOverrides []string
 )
 
 var rootCmd = &cobra.Command{
@@ -18,7 +21,10 @@ var rootCmd = &cobra.Command{
 	Short: "Code topology tools for LLMs",
 	Long: `topo is an MCP (Model Context Protocol) server providing code navigation tools for LLMs.
 It parses source files and provides tools to index symbols, read/write definitions,
-and find references across codebases. Supports Go, Python, TypeScript/JavaScript, and Rust.`,
+and find references across codebases. Supports Go, Python, TypeScript/JavaScript, Rust, Java, C/C++, C#, Ruby, PHP, Kotlin, Swift, Bash, SQL, Protocol Buffers, HCL/Terraform, YAML, JSON, TOML, Vue, Svelte, Lua, Elixir, Scala, Makefiles, and Dockerfiles.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return configureLanguages(langOverrides)
+	},
 }
 
 var mcpCmd = &cobra.Command{
@@ -35,7 +41,10 @@ var mapCmd = &cobra.Command{
 	Use:   "map [path]",
 	Short: "Index a directory and print the map to stdout",
 	Long: `Index a codebase directory and print a compact listing of all symbols
-(functions, types, classes, etc.) with their line ranges to stdout.`,
+(functions, types, classes, etc.) with their line ranges to stdout.
+
+Use --format json, ndjson or csv for machine-readable output listing every file's
+language, imports and symbols with exact ranges. These formats ignore --limit.`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		path := "."
@@ -43,7 +52,27 @@ var mapCmd = &cobra.Command{
 			path = args[0]
 		}
 		filter, _ := cmd.Flags().GetString("filter")
-		return runMap(path, skipPatterns, filter, lineLimit)
+		format, _ := cmd.Flags().GetString("format")
+		return runMap(path, skipPatterns, filter, lineLimit, format)
+	},
+}
+
+var tagsCmd = &cobra.Command{
+	Use:   "tags [path]",
+	Short: "Write a ctags-compatible tags file for a directory",
+	Long: `Index a codebase directory and write a sorted tags file in the extended
+ctags format (with kind, scope and signature fields) for vim and other editors.
+Use --etags to also write a TAGS file for Emacs. Honours --skip and .gitignore
+the same way the index does.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := "."
+		if len(args) > 0 {
+			path = args[0]
+		}
+		output, _ := cmd.Flags().GetString("output")
+		etags, _ := cmd.Flags().GetBool("etags")
+		return runTags(path, skipPatterns, output, etags)
 	},
 }
 
@@ -56,12 +85,45 @@ func init() {
 	rootCmd.PersistentFlags().IntVar(&lineLimit, "limit", tools.DefaultLineLimit,
 		"Maximum lines in index output (default 1000, 0 = no limit)")
 
+	// Add --lang flag to root (inherited by all subcommands)
+	rootCmd.PersistentFlags().StringArrayVar(&langOverrides, "lang", nil,
+		"Parse files matching a pattern as a language, e.g. '*.tpl=go' (can be specified multiple times)")
+
 	// Add --filter flag to map command
 	mapCmd.Flags().StringP("filter", "f", "",
 		"Only show symbols for files matching this path prefix (file or directory)")
 
+	// Add --format flag to map command
+	mapCmd.Flags().String("format", "text",
+		"Output format: text, "+strings.Join(tools.ExportFormats, ", "))
+
+	// Add --output and --etags flags to tags command
+	tagsCmd.Flags().StringP("output", "o", "",
+		"Path of the tags file (default: tags in the indexed directory)")
+	tagsCmd.Flags().BoolP("etags", "e", false,
+		"Also write an Emacs TAGS file next to the tags file")
+
 	rootCmd.AddCommand(mcpCmd)
 	rootCmd.AddCommand(mapCmd)
+	rootCmd.AddCommand(tagsCmd)
+}
+
+// configureLanguages applies --lang overrides and warns about file types
+// claimed by more than one compiled-in language
+func configureLanguages(overrides []string) error {
+	for _, override := range overrides {
+		glob, lang, ok := strings.Cut(override, "=")
+		if !ok {
+			return fmt.Errorf("invalid --lang %q: expected PATTERN=LANGUAGE", override)
+		}
+		if err := languages.Override(strings.TrimSpace(glob), strings.TrimSpace(lang)); err != nil {
+			return fmt.Errorf("invalid --lang %q: %w", override, err)
+		}
+	}
+	for _, conflict := range languages.Conflicts() {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", conflict)
+	}
+	return nil
 }
 
 func main() {
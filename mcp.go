@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 
@@ -13,7 +14,7 @@ import (
 // serverConfig holds the server configuration
 var serverConfig *tools.Config
 
-func runMap(path string, skipPatterns []string, filter string, lineLimit int) error {
+func runMap(path string, skipPatterns []string, filter string, lineLimit int, format string) error {
 	// Make path absolute if relative
 	if !filepath.IsAbs(path) {
 		cwd, err := os.Getwd()
@@ -28,11 +29,16 @@ func runMap(path string, skipPatterns []string, filter string, lineLimit int) er
 		return fmt.Errorf("failed to index directory: %w", err)
 	}
 
-	output := tools.FormatCodemap(files, tools.FormatOptions{
+	opts := tools.FormatOptions{
 		SkipPatterns: skipPatterns,
 		Filter:       filter,
 		LineLimit:    lineLimit,
-	})
+	}
+	if format != "text" {
+		return tools.ExportCodemap(os.Stdout, files, opts, format)
+	}
+
+	output := tools.FormatCodemap(files, opts)
 	if output == "" {
 		output = "No symbols found in the specified directory."
 	}
@@ -41,6 +47,57 @@ func runMap(path string, skipPatterns []string, filter string, lineLimit int) er
 	return nil
 }
 
+func runTags(path string, skipPatterns []string, output string, etags bool) error {
+	// Make path absolute if relative
+	if !filepath.IsAbs(path) {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return fmt.Errorf("failed to get working directory: %w", err)
+		}
+		path = filepath.Join(cwd, path)
+	}
+	if output == "" {
+		output = filepath.Join(path, "tags")
+	}
+	output, err := filepath.Abs(output)
+	if err != nil {
+		return fmt.Errorf("failed to resolve output path: %w", err)
+	}
+
+	files, err := tools.IndexDirectory(path)
+	if err != nil {
+		return fmt.Errorf("failed to index directory: %w", err)
+	}
+
+	opts := tools.TagsOptions{
+		Root:         path,
+		BaseDir:      filepath.Dir(output),
+		SkipPatterns: skipPatterns,
+	}
+	if err := writeTagsFile(output, files, opts, tools.WriteCtags); err != nil {
+		return err
+	}
+	if etags {
+		return writeTagsFile(filepath.Join(filepath.Dir(output), "TAGS"), files, opts, tools.WriteEtags)
+	}
+	return nil
+}
+
+// writeTagsFile creates a tags file and writes it with the given writer
+func writeTagsFile(path string, files []tools.FileIndex, opts tools.TagsOptions,
+	write func(io.Writer, []tools.FileIndex, tools.TagsOptions) error,
+) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	if err := write(f, files, opts); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return f.Close()
+}
+
 // explorePrompt is the system prompt for code navigation
 const explorePrompt = `You are an expert code navigator. Your job is to quickly find and explain code structure.
 
@@ -65,7 +122,7 @@ You have access to topo MCP tools. ALWAYS prefer these over built-in tools:
 
 Only use Read/Glob/Grep when:
 - Looking at non-code files (config, docs, etc.)
-- The file type isn't supported by topo (check: Go, Python, TypeScript/JavaScript, Rust, Markdown)
+- The file type isn't supported by topo (check: Go, Python, TypeScript/JavaScript, Rust, Java, C/C++, C#, Ruby, PHP, Kotlin, Swift, Bash, SQL, Protocol Buffers, HCL/Terraform, YAML, JSON, TOML, Vue, Svelte, Lua, Elixir, Scala, Makefile, Dockerfile, Markdown)
 - You need to see the full file context, not just a symbol
 
 ## Response Style
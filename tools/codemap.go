@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/roveo/topo-mcp/languages"
 )
 
 // CodemapInput is the input schema for the codemap tool
@@ -27,19 +28,21 @@ USE THIS FIRST when exploring unfamiliar code or finding where something is defi
 
 Typical workflow: index → find symbol → read_definition to get source code.
 
-Use 'filter' param to focus on a specific directory (e.g., filter='handlers').`,
+Use 'filter' param to focus on a specific directory (e.g., filter='handlers').
+
+Each symbol ends with its ID fragment: 'topo://' + file path + fragment (e.g., 'topo://tools/codemap.go#FormatCodemap') is a stable ID accepted by read_definition, write_definition and find_references.`,
 	}
 }
 
 // CodemapHandler handles the codemap tool invocation
-func CodemapHandler(cfg *Config) func(context.Context, *mcp.CallToolRequest, CodemapInput) (*mcp.CallToolResult, any, error) {
-	return func(ctx context.Context, req *mcp.CallToolRequest, input CodemapInput) (*mcp.CallToolResult, any, error) {
+func CodemapHandler(cfg *Config) func(context.Context, *mcp.CallToolRequest, CodemapInput) (*mcp.CallToolResult, IndexOutput, error) {
+	return func(ctx context.Context, req *mcp.CallToolRequest, input CodemapInput) (*mcp.CallToolResult, IndexOutput, error) {
 		dir := input.Path
 		if dir == "" {
 			var err error
 			dir, err = os.Getwd()
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to get working directory: %w", err)
+				return nil, IndexOutput{}, fmt.Errorf("failed to get working directory: %w", err)
 			}
 		}
 
@@ -47,21 +50,38 @@ func CodemapHandler(cfg *Config) func(context.Context, *mcp.CallToolRequest, Cod
 		if !filepath.IsAbs(dir) {
 			cwd, err := os.Getwd()
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to get working directory: %w", err)
+				return nil, IndexOutput{}, fmt.Errorf("failed to get working directory: %w", err)
 			}
 			dir = filepath.Join(cwd, dir)
 		}
 
 		files, err := IndexDirectory(dir)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to index directory: %w", err)
+			return nil, IndexOutput{}, fmt.Errorf("failed to index directory: %w", err)
+		}
+
+		// Paths (and so IDs) are relative to the working directory, not to dir
+		base, err := rootRelative(dir)
+		if err != nil {
+			return nil, IndexOutput{}, err
+		}
+		filter := input.Filter
+		if base != "." {
+			for i := range files {
+				files[i].Path = filepath.Join(base, files[i].Path)
+			}
+			if filter != "" {
+				filter = filepath.Join(base, filter)
+			}
 		}
 
-		output := FormatCodemap(files, FormatOptions{
+		opts := FormatOptions{
 			SkipPatterns: cfg.SkipPatterns,
-			Filter:       input.Filter,
+			Filter:       filter,
 			LineLimit:    cfg.LineLimit,
-		})
+			SymbolIDs:    true,
+		}
+		output := FormatCodemap(files, opts)
 		if output == "" {
 			output = "No symbols found in the specified directory."
 		}
@@ -70,7 +90,7 @@ func CodemapHandler(cfg *Config) func(context.Context, *mcp.CallToolRequest, Cod
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: output},
 			},
-		}, nil, nil
+		}, CodemapResult(files, opts), nil
 	}
 }
 
@@ -79,31 +99,19 @@ type FormatOptions struct {
 	SkipPatterns []string // Path prefixes to skip by default
 	Filter       string   // If set, only show files matching this prefix (overrides skip)
 	LineLimit    int      // Maximum lines in output (0 = no limit, default = DefaultLineLimit)
+	SymbolIDs    bool     // Append each symbol's ID fragment (e.g., "#(*Server).Start")
 }
 
 // FormatCodemap formats the index in a compact human-readable format
 func FormatCodemap(files []FileIndex, opts FormatOptions) string {
-	// Apply line limit if set
-	limit := opts.LineLimit
-	if limit == 0 {
-		limit = DefaultLineLimit
-	}
-
-	// Build tree and prune if necessary
-	tree := buildDirTree(files, opts)
-	prunedFiles := pruneToLimit(tree, limit)
+	skippedFiles, prunedFiles := layoutCodemap(files, opts)
 
 	var sb strings.Builder
 
 	// Handle skipped files (not pruned, but skipped by skip patterns)
-	for _, file := range files {
-		if opts.Filter != "" {
-			continue // Filter overrides skip
-		}
-		if isSkipped(file.Path, opts.SkipPatterns) {
-			sb.WriteString(fmt.Sprintf("## %s\n", file.Path))
-			sb.WriteString("  (skipped by default - use filter parameter to index this path explicitly)\n\n")
-		}
+	for _, file := range skippedFiles {
+		sb.WriteString(fmt.Sprintf("## %s\n", file.Path))
+		sb.WriteString("  (skipped by default - use filter parameter to index this path explicitly)\n\n")
 	}
 
 	// Format files
@@ -120,34 +128,96 @@ func FormatCodemap(files []FileIndex, opts FormatOptions) string {
 			continue
 		}
 
-		for _, sym := range file.Symbols {
+		var selectors []string
+		if opts.SymbolIDs {
+			selectors = symbolSelectors(file.Symbols)
+		}
+
+		i := 0
+		languages.Walk(file.Symbols, func(sym languages.Symbol, depth int) {
 			loc := sym.Location()
 			// Convert 0-based to 1-based for display
 			startLine := loc.Start.Line + 1
 			endLine := loc.End.Line + 1
 
+			// Nested symbols are indented under their parent
+			indent := strings.Repeat("  ", depth+1)
+
 			var line string
 			if startLine == endLine {
-				line = fmt.Sprintf("  %s [%d]", sym.String(), startLine)
+				line = fmt.Sprintf("%s%s [%d]", indent, sym.String(), startLine)
 			} else {
-				line = fmt.Sprintf("  %s [%d-%d]", sym.String(), startLine, endLine)
+				line = fmt.Sprintf("%s%s [%d-%d]", indent, sym.String(), startLine, endLine)
+			}
+
+			// The file header and the fragment together form the symbol ID
+			if opts.SymbolIDs {
+				line += " #" + selectors[i]
 			}
+			i++
 
 			// Add docstring for types and functions if available
-			if doc, ok := sym.(interface{ DocComment() string }); ok {
+			if doc, ok := sym.(languages.Documented); ok {
 				if docStr := doc.DocComment(); docStr != "" {
 					line += " // " + docStr
 				}
 			}
 
 			sb.WriteString(line + "\n")
-		}
+		})
 		sb.WriteString("\n")
 	}
 
 	return sb.String()
 }
 
+// CodemapResult returns the structured form of the codemap.
+// It lists the same files as FormatCodemap with the same options.
+func CodemapResult(files []FileIndex, opts FormatOptions) IndexOutput {
+	skippedFiles, prunedFiles := layoutCodemap(files, opts)
+
+	output := IndexOutput{Files: []FileResult{}}
+	for _, file := range skippedFiles {
+		output.Files = append(output.Files, FileResult{
+			Path:     filepath.ToSlash(file.Path),
+			Language: file.Language,
+			Skipped:  true,
+		})
+	}
+	for _, file := range prunedFiles {
+		if len(file.Symbols) == 0 && !file.Truncated {
+			continue
+		}
+		output.Files = append(output.Files, fileResult(file))
+	}
+	return output
+}
+
+// layoutCodemap splits the files into those skipped by the skip patterns and
+// those shown after filtering and pruning to the line limit
+func layoutCodemap(files []FileIndex, opts FormatOptions) (skipped, shown []FileIndex) {
+	// Apply line limit if set
+	limit := opts.LineLimit
+	if limit == 0 {
+		limit = DefaultLineLimit
+	}
+
+	// Build tree and prune if necessary
+	tree := buildDirTree(files, opts)
+	shown = pruneToLimit(tree, limit)
+
+	// Filter overrides skip
+	if opts.Filter == "" {
+		for _, file := range files {
+			if isSkipped(file.Path, opts.SkipPatterns) {
+				skipped = append(skipped, file)
+			}
+		}
+	}
+
+	return skipped, shown
+}
+
 // matchesFilter checks if a file path matches the filter.
 // Supports both exact file match and directory/package prefix match.
 func matchesFilter(filePath, filter string) bool {
@@ -183,12 +253,12 @@ func isSkipped(filePath string, patterns []string) bool {
 }
 
 // fileLineCount returns the number of output lines a file would produce
-// Each file contributes: 1 (header) + len(symbols) + 1 (blank line)
+// Each file contributes: 1 (header) + symbols including nested ones + 1 (blank line)
 func fileLineCount(file FileIndex) int {
 	if len(file.Symbols) == 0 {
 		return 0
 	}
-	return 1 + len(file.Symbols) + 1 // header + symbols + blank line
+	return 1 + len(languages.Flatten(file.Symbols)) + 1 // header + symbols + blank line
 }
 
 // dirNode represents a directory in the tree structure for pruning
@@ -1,6 +1,8 @@
 package tools
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -19,6 +21,14 @@ func (s mockSymbol) Kind() string              { return s.symbolKind }
 func (s mockSymbol) String() string            { return s.symbolKind + " " + s.symbolName }
 func (s mockSymbol) Location() languages.Range { return s.loc }
 
+// mockContainer is a mockSymbol with nested children
+type mockContainer struct {
+	mockSymbol
+	children []languages.Symbol
+}
+
+func (c mockContainer) Children() []languages.Symbol { return c.children }
+
 func makeTestFiles(count int, symbolsPerFile int) []FileIndex {
 	files := make([]FileIndex, count)
 	for i := 0; i < count; i++ {
@@ -343,3 +353,118 @@ func TestIsSkipped(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatCodemap_NestedSymbols(t *testing.T) {
+	class := mockContainer{
+		mockSymbol: mockSymbol{symbolName: "Server", symbolKind: "class", loc: languages.Range{
+			Start: languages.Position{Line: 0},
+			End:   languages.Position{Line: 9},
+		}},
+		children: []languages.Symbol{
+			mockSymbol{symbolName: "start", symbolKind: "method", loc: languages.Range{
+				Start: languages.Position{Line: 2},
+				End:   languages.Position{Line: 4},
+			}},
+		},
+	}
+	files := []FileIndex{
+		{Path: "server.py", Language: "python", Symbols: []languages.Symbol{class}},
+	}
+
+	if got := fileLineCount(files[0]); got != 4 {
+		t.Errorf("fileLineCount() = %d, want 4 (header + class + method + blank)", got)
+	}
+
+	output := FormatCodemap(files, FormatOptions{})
+	if !strings.Contains(output, "\n  class Server [1-10]\n") {
+		t.Errorf("expected top-level class line, got:\n%s", output)
+	}
+	if !strings.Contains(output, "\n    method start [3-5]\n") {
+		t.Errorf("expected method indented under class, got:\n%s", output)
+	}
+}
+
+func TestFormatCodemap_SymbolIDs(t *testing.T) {
+	class := mockContainer{
+		mockSymbol: mockSymbol{symbolName: "Server", symbolKind: "class", loc: languages.Range{
+			Start: languages.Position{Line: 0},
+			End:   languages.Position{Line: 9},
+		}},
+		children: []languages.Symbol{
+			mockSymbol{symbolName: "start", symbolKind: "method", loc: languages.Range{
+				Start: languages.Position{Line: 2},
+				End:   languages.Position{Line: 4},
+			}},
+		},
+	}
+	files := []FileIndex{
+		{Path: "server.py", Language: "python", Symbols: []languages.Symbol{class}},
+	}
+
+	output := FormatCodemap(files, FormatOptions{SymbolIDs: true})
+	if !strings.Contains(output, "\n  class Server [1-10] #Server\n") {
+		t.Errorf("expected class ID fragment, got:\n%s", output)
+	}
+	if !strings.Contains(output, "\n    method start [3-5] #start\n") {
+		t.Errorf("expected method ID fragment, got:\n%s", output)
+	}
+
+	if output := FormatCodemap(files, FormatOptions{}); strings.Contains(output, "#Server") {
+		t.Errorf("expected no ID fragments by default, got:\n%s", output)
+	}
+}
+
+func TestIndexDirectory_SkipsLockFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	for name, content := range map[string]string{
+		"package.json":      `{"scripts": {"build": "tsc"}}`,
+		"package-lock.json": `{"packages": {"": {"name": "app"}}}`,
+	} {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	files, err := IndexDirectory(tmpDir)
+	if err != nil {
+		t.Fatalf("IndexDirectory error: %v", err)
+	}
+	if len(files) != 1 || files[0].Path != "package.json" {
+		t.Errorf("expected only package.json to be indexed, got %v", files)
+	}
+}
+
+// panickingLanguage is a parser that crashes on every file
+type panickingLanguage struct{}
+
+func (p *panickingLanguage) Name() string         { return "panicking" }
+func (p *panickingLanguage) Extensions() []string { return []string{".panic"} }
+func (p *panickingLanguage) Parse(content []byte) ([]string, []languages.Symbol, error) {
+	panic("index out of range")
+}
+
+func TestIndexDirectory_SkipsPanickingParser(t *testing.T) {
+	languages.Register(&panickingLanguage{})
+
+	tmpDir := t.TempDir()
+	for name, content := range map[string]string{
+		"bad.panic": "anything",
+		"main.go":   "package main\n\nfunc main() {}\n",
+	} {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	files, err := IndexDirectory(tmpDir)
+	if err != nil {
+		t.Fatalf("IndexDirectory error: %v", err)
+	}
+	if len(files) != 1 || files[0].Path != "main.go" {
+		t.Errorf("expected only main.go to be indexed, got %v", files)
+	}
+
+	if _, err := ParseFile(filepath.Join(tmpDir, "bad.panic")); err == nil {
+		t.Error("expected ParseFile to return an error for a panicking parser")
+	}
+}
@@ -0,0 +1,119 @@
+package tools
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+)
+
+// ExportFormats lists the machine-readable codemap formats
+var ExportFormats = []string{"json", "ndjson", "csv"}
+
+// csvHeader is the header row of the CSV export. Every row is a record of
+// type "file", "skipped", "import" or "symbol"; columns that don't apply are empty.
+var csvHeader = []string{
+	"record", "path", "language", "id", "parent", "kind", "name", "signature", "doc",
+	"start_line", "start_column", "end_line", "end_column",
+}
+
+// ExportCodemap writes the codemap in a machine-readable format.
+// Unlike FormatCodemap, the line limit is not applied: every matching file is
+// listed in full, including files without symbols.
+func ExportCodemap(w io.Writer, files []FileIndex, opts FormatOptions, format string) error {
+	output := exportResult(files, opts)
+
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		return enc.Encode(output)
+	case "ndjson":
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		for _, file := range output.Files {
+			if err := enc.Encode(file); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "csv":
+		return exportCSV(w, output)
+	default:
+		return fmt.Errorf("unknown format %q (supported: text, json, ndjson, csv)", format)
+	}
+}
+
+// exportResult returns the structured form of all files that pass the filter,
+// sorted by path. Files matching the skip patterns are listed as skipped.
+func exportResult(files []FileIndex, opts FormatOptions) IndexOutput {
+	sorted := make([]FileIndex, len(files))
+	copy(sorted, files)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Path < sorted[j].Path
+	})
+
+	output := IndexOutput{Files: []FileResult{}}
+	for _, file := range sorted {
+		switch {
+		case opts.Filter != "":
+			if !matchesFilter(file.Path, opts.Filter) {
+				continue
+			}
+		case isSkipped(file.Path, opts.SkipPatterns):
+			output.Files = append(output.Files, FileResult{
+				Path:     filepath.ToSlash(file.Path),
+				Language: file.Language,
+				Skipped:  true,
+			})
+			continue
+		}
+		output.Files = append(output.Files, fileResult(file))
+	}
+	return output
+}
+
+// exportCSV writes one row per file, import and symbol
+func exportCSV(w io.Writer, output IndexOutput) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, file := range output.Files {
+		record := "file"
+		if file.Skipped {
+			record = "skipped"
+		}
+		rows := [][]string{csvRow(record, file.Path, file.Language)}
+		for _, imp := range file.Imports {
+			row := csvRow("import", file.Path, file.Language)
+			row[6] = imp
+			rows = append(rows, row)
+		}
+		for _, sym := range file.Symbols {
+			rows = append(rows, []string{
+				"symbol", file.Path, file.Language, sym.ID, sym.Parent, sym.Kind, sym.Name, sym.Signature, sym.Doc,
+				strconv.Itoa(sym.Range.Start.Line), strconv.Itoa(sym.Range.Start.Column),
+				strconv.Itoa(sym.Range.End.Line), strconv.Itoa(sym.Range.End.Column),
+			})
+		}
+		if err := cw.WriteAll(rows); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvRow returns a row with only the record type, path and language set
+func csvRow(record, path, language string) []string {
+	row := make([]string, len(csvHeader))
+	row[0], row[1], row[2] = record, path, language
+	return row
+}
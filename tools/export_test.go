@@ -0,0 +1,127 @@
+package tools
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+func exportTestFiles() []FileIndex {
+	files := makeTestFilesInDirs([]string{"cmd", "vendor/lib"}, 2)
+	files = append(files, FileIndex{Path: "doc.go", Language: "go", Imports: []string{"fmt"}})
+	return files
+}
+
+func TestExportCodemap_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	err := ExportCodemap(&buf, exportTestFiles(), FormatOptions{SkipPatterns: []string{"vendor"}}, "json")
+	if err != nil {
+		t.Fatalf("ExportCodemap error: %v", err)
+	}
+
+	var output IndexOutput
+	if err := json.Unmarshal(buf.Bytes(), &output); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+
+	// Sorted by path; files without symbols are kept, skipped files are flagged
+	if len(output.Files) != 3 {
+		t.Fatalf("expected 3 files, got %d", len(output.Files))
+	}
+	paths := []string{"cmd/main.go", "doc.go", "vendor/lib/main.go"}
+	for i, want := range paths {
+		if output.Files[i].Path != want {
+			t.Errorf("files[%d].Path = %q, want %q", i, output.Files[i].Path, want)
+		}
+	}
+	if len(output.Files[1].Imports) != 1 || len(output.Files[1].Symbols) != 0 {
+		t.Errorf("unexpected doc.go result: %+v", output.Files[1])
+	}
+	if !output.Files[2].Skipped {
+		t.Errorf("expected vendor file to be skipped")
+	}
+}
+
+func TestExportCodemap_NoHTMLEscaping(t *testing.T) {
+	files := []FileIndex{{Path: "box.rs", Language: "rust", Symbols: []languages.Symbol{
+		mockSymbol{symbolName: "Box<T>&", symbolKind: "impl"},
+	}}}
+	for _, format := range []string{"json", "ndjson"} {
+		var buf bytes.Buffer
+		if err := ExportCodemap(&buf, files, FormatOptions{}, format); err != nil {
+			t.Fatalf("ExportCodemap error: %v", err)
+		}
+		if !strings.Contains(buf.String(), "impl Box<T>&") {
+			t.Errorf("%s: expected signature to be written as is:\n%s", format, buf.String())
+		}
+	}
+}
+
+func TestExportCodemap_IgnoresLineLimit(t *testing.T) {
+	files := makeTestFiles(5, 100)
+
+	var buf bytes.Buffer
+	err := ExportCodemap(&buf, files, FormatOptions{LineLimit: 50}, "ndjson")
+	if err != nil {
+		t.Fatalf("ExportCodemap error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("expected 5 NDJSON lines, got %d", len(lines))
+	}
+	for _, line := range lines {
+		var file FileResult
+		if err := json.Unmarshal([]byte(line), &file); err != nil {
+			t.Fatalf("invalid NDJSON line: %v", err)
+		}
+		if file.Truncated || len(file.Symbols) != 100 {
+			t.Errorf("expected all 100 symbols of %s, got %d", file.Path, len(file.Symbols))
+		}
+	}
+}
+
+func TestExportCodemap_CSV(t *testing.T) {
+	files := []FileIndex{{
+		Path:     "main.go",
+		Language: "go",
+		Imports:  []string{"fmt"},
+		Symbols: []languages.Symbol{mockSymbol{symbolName: "main", symbolKind: "func", loc: languages.Range{
+			Start: languages.Position{Line: 2, Character: 0},
+			End:   languages.Position{Line: 4, Character: 1},
+		}}},
+	}}
+
+	var buf bytes.Buffer
+	if err := ExportCodemap(&buf, files, FormatOptions{}, "csv"); err != nil {
+		t.Fatalf("ExportCodemap error: %v", err)
+	}
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v", err)
+	}
+	if len(rows) != 4 {
+		t.Fatalf("expected header + file + import + symbol rows, got %d", len(rows))
+	}
+	want := []string{"symbol", "main.go", "go", "topo://main.go#main", "", "func", "main", "func main", "", "3", "1", "5", "2"}
+	for i := range want {
+		if rows[3][i] != want[i] {
+			t.Errorf("symbol row column %s = %q, want %q", csvHeader[i], rows[3][i], want[i])
+		}
+	}
+	if rows[2][0] != "import" || rows[2][6] != "fmt" {
+		t.Errorf("unexpected import row: %v", rows[2])
+	}
+}
+
+func TestExportCodemap_UnknownFormat(t *testing.T) {
+	var buf bytes.Buffer
+	if err := ExportCodemap(&buf, nil, FormatOptions{}, "xml"); err == nil {
+		t.Error("expected error for unknown format")
+	}
+}
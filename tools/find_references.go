@@ -16,7 +16,7 @@ import (
 // FindReferencesInput is the input schema for the find_references tool
 type FindReferencesInput struct {
 	Path   string `json:"path,omitempty" jsonschema_description:"Directory to search in. Defaults to current working directory."`
-	Symbol string `json:"symbol" jsonschema_description:"Name of the symbol to find references for."`
+	Symbol string `json:"symbol" jsonschema_description:"Name of the symbol to find references for, or its symbol ID (e.g., 'topo://cmd/main.go#(*Server).Start'), resolved relative to the working directory. References are matched by name: an ID's receiver and '#N' ordinal only pick the symbol whose name is searched for."`
 }
 
 // FindReferencesTool creates the find_references MCP tool
@@ -27,15 +27,17 @@ func FindReferencesTool() *mcp.Tool {
 
 Syntax-aware: only finds actual code references, not strings or comments. Better than grep for code navigation.
 
-Use before refactoring to see what would be affected, or to understand how a function/type is used.`,
+Use before refactoring to see what would be affected, or to understand how a function/type is used.
+
+Each reference is followed by the ID of its enclosing symbol, which can be passed to read_definition.`,
 	}
 }
 
 // FindReferencesHandler handles the find_references tool invocation
-func FindReferencesHandler(cfg *Config) func(context.Context, *mcp.CallToolRequest, FindReferencesInput) (*mcp.CallToolResult, any, error) {
-	return func(ctx context.Context, req *mcp.CallToolRequest, input FindReferencesInput) (*mcp.CallToolResult, any, error) {
+func FindReferencesHandler(cfg *Config) func(context.Context, *mcp.CallToolRequest, FindReferencesInput) (*mcp.CallToolResult, FindReferencesOutput, error) {
+	return func(ctx context.Context, req *mcp.CallToolRequest, input FindReferencesInput) (*mcp.CallToolResult, FindReferencesOutput, error) {
 		if input.Symbol == "" {
-			return nil, nil, fmt.Errorf("symbol name is required")
+			return nil, FindReferencesOutput{}, fmt.Errorf("symbol name is required")
 		}
 
 		dir := input.Path
@@ -43,7 +45,7 @@ func FindReferencesHandler(cfg *Config) func(context.Context, *mcp.CallToolReque
 			var err error
 			dir, err = os.Getwd()
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to get working directory: %w", err)
+				return nil, FindReferencesOutput{}, fmt.Errorf("failed to get working directory: %w", err)
 			}
 		}
 
@@ -51,27 +53,52 @@ func FindReferencesHandler(cfg *Config) func(context.Context, *mcp.CallToolReque
 		if !filepath.IsAbs(dir) {
 			cwd, err := os.Getwd()
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to get working directory: %w", err)
+				return nil, FindReferencesOutput{}, fmt.Errorf("failed to get working directory: %w", err)
 			}
 			dir = filepath.Join(cwd, dir)
 		}
 
-		refs, err := FindReferences(dir, input.Symbol)
+		symbolName := input.Symbol
+		if IsSymbolID(symbolName) {
+			// IDs are relative to the working directory, like the file argument of read_definition
+			file, selector, err := ParseSymbolID(symbolName)
+			if err != nil {
+				return nil, FindReferencesOutput{}, err
+			}
+			sym, _, err := FindSymbol(file, selector)
+			if err != nil {
+				return nil, FindReferencesOutput{}, err
+			}
+			symbolName = sym.Name()
+		}
+
+		refs, err := FindReferences(dir, symbolName)
 		if err != nil {
-			return nil, nil, err
+			return nil, FindReferencesOutput{}, err
 		}
 
+		// Paths (and so IDs) are relative to the working directory, not to dir
+		base, err := rootRelative(dir)
+		if err != nil {
+			return nil, FindReferencesOutput{}, err
+		}
+		if base != "." {
+			rebaseReferences(refs, base)
+		}
+
+		output := FindReferencesOutput{Symbol: symbolName, References: refs}
 		if len(refs) == 0 {
+			output.References = []Reference{}
 			return &mcp.CallToolResult{
 				Content: []mcp.Content{
-					&mcp.TextContent{Text: fmt.Sprintf("No references found for %q", input.Symbol)},
+					&mcp.TextContent{Text: fmt.Sprintf("No references found for %q", symbolName)},
 				},
-			}, nil, nil
+			}, output, nil
 		}
 
 		// Format output
 		var sb strings.Builder
-		sb.WriteString(fmt.Sprintf("# References to %q (%d found)\n\n", input.Symbol, len(refs)))
+		sb.WriteString(fmt.Sprintf("# References to %q (%d found)\n\n", symbolName, len(refs)))
 
 		currentFile := ""
 		for _, ref := range refs {
@@ -82,23 +109,28 @@ func FindReferencesHandler(cfg *Config) func(context.Context, *mcp.CallToolReque
 				sb.WriteString(fmt.Sprintf("## %s\n", ref.File))
 				currentFile = ref.File
 			}
-			sb.WriteString(fmt.Sprintf("  [%d:%d] %s\n", ref.Line, ref.Column, ref.Context))
+			sb.WriteString(fmt.Sprintf("  [%d:%d] %s", ref.Line, ref.Column, ref.Context))
+			if ref.Symbol != "" {
+				sb.WriteString("  (in " + ref.Symbol + ")")
+			}
+			sb.WriteString("\n")
 		}
 
 		return &mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: sb.String()},
 			},
-		}, nil, nil
+		}, output, nil
 	}
 }
 
 // Reference represents a single reference to a symbol
 type Reference struct {
-	File    string // Relative file path
-	Line    int    // 1-based line number
-	Column  int    // 1-based column number
-	Context string // The line of code containing the reference
+	File    string `json:"file"`             // Relative file path
+	Line    int    `json:"line"`             // 1-based line number
+	Column  int    `json:"column"`           // 1-based column number
+	Context string `json:"context"`          // The line of code containing the reference
+	Symbol  string `json:"symbol,omitempty"` // ID of the innermost symbol containing the reference ("" at top level)
 }
 
 // FindReferences finds all references to a symbol in a directory
@@ -136,6 +168,9 @@ func FindReferences(dir string, symbolName string) ([]Reference, error) {
 		if gitignoreMatcher != nil && gitignoreMatcher.Match(relPath, false) {
 			return nil
 		}
+		if generatedFiles[info.Name()] {
+			return nil
+		}
 
 		// Get the language for this file
 		lang := languages.GetLanguageForFile(path)
@@ -155,9 +190,18 @@ func FindReferences(dir string, symbolName string) ([]Reference, error) {
 			return nil // Skip files that can't be parsed
 		}
 
-		// Add file path to references
+		// Add file path and enclosing symbols to references
+		var symbols []languages.Symbol
+		if len(fileRefs) > 0 {
+			_, symbols, _ = parseContent(lang, path, content)
+		}
+		ids := symbolIDs(relPath, symbols)
+		flat := languages.Flatten(symbols)
 		for i := range fileRefs {
 			fileRefs[i].File = relPath
+			if idx := enclosingSymbol(flat, fileRefs[i].Line-1, fileRefs[i].Column-1); idx != -1 {
+				fileRefs[i].Symbol = ids[idx]
+			}
 		}
 
 		refs = append(refs, fileRefs...)
@@ -167,6 +211,17 @@ func FindReferences(dir string, symbolName string) ([]Reference, error) {
 	return refs, err
 }
 
+// rebaseReferences prefixes the file paths and enclosing symbol IDs of
+// references found in a directory with the directory's own path
+func rebaseReferences(refs []Reference, base string) {
+	for i, ref := range refs {
+		refs[i].File = filepath.Join(base, ref.File)
+		if file, selector, err := ParseSymbolID(ref.Symbol); err == nil {
+			refs[i].Symbol = SymbolID(filepath.Join(base, file), selector)
+		}
+	}
+}
+
 // findReferencesInFile finds all references to a symbol in a single file
 func findReferencesInFile(content []byte, symbolName string, lang languages.Language) ([]Reference, error) {
 	// Check if language supports tree-sitter
@@ -194,29 +249,26 @@ func findReferencesInFile(content []byte, symbolName string, lang languages.Lang
 			return
 		}
 
-		// Check if this is an identifier-like node
-		if isIdentifierNode(node, lang.Name()) {
-			name := node.Content(content)
-			if name == symbolName {
-				line := int(node.StartPoint().Row)
-				col := int(node.StartPoint().Column)
-
-				// Get context (the line of code)
-				context := ""
-				if line < len(lines) {
-					context = strings.TrimSpace(lines[line])
-					// Truncate long lines
-					if len(context) > 100 {
-						context = context[:97] + "..."
-					}
+		// Check if this is a reference to the symbol
+		if isReference(node, content, symbolName, lang.Name()) {
+			line := int(node.StartPoint().Row)
+			col := int(node.StartPoint().Column)
+
+			// Get context (the line of code)
+			context := ""
+			if line < len(lines) {
+				context = strings.TrimSpace(lines[line])
+				// Truncate long lines
+				if len(context) > 100 {
+					context = context[:97] + "..."
 				}
-
-				refs = append(refs, Reference{
-					Line:    line + 1, // Convert to 1-based
-					Column:  col + 1,
-					Context: context,
-				})
 			}
+
+			refs = append(refs, Reference{
+				Line:    line + 1, // Convert to 1-based
+				Column:  col + 1,
+				Context: context,
+			})
 		}
 
 		// Recurse into children
@@ -229,6 +281,71 @@ func findReferencesInFile(content []byte, symbolName string, lang languages.Lang
 	return refs, nil
 }
 
+// enclosingSymbol returns the index of the innermost symbol containing a
+// 0-based position, or -1 if the position is outside all symbols.
+// Symbols must be flattened in source order, so later matches are nested deeper.
+func enclosingSymbol(flat []languages.Symbol, line, col int) int {
+	found := -1
+	for i, sym := range flat {
+		loc := sym.Location()
+		if line < loc.Start.Line || line > loc.End.Line {
+			continue
+		}
+		if line == loc.Start.Line && col < loc.Start.Character {
+			continue
+		}
+		if line == loc.End.Line && col >= loc.End.Character {
+			continue
+		}
+		found = i
+	}
+	return found
+}
+
+// isReference checks if a node references the symbol. In HCL, dotted symbol
+// names (e.g., "var.region", "module.vpc") match traversals that start with
+// them ("module.vpc.id"). Elixir aliases are single dotted nodes, so
+// "Shop.Item" matches "Item" as well as "Shop.Item". Dockerfile stages also
+// match --from flags. Elsewhere, identifiers must match exactly.
+func isReference(node *sitter.Node, content []byte, symbolName, langName string) bool {
+	if langName == "hcl" && strings.Contains(symbolName, ".") {
+		return node.Type() == "variable_expr" &&
+			strings.HasPrefix(hclTraversal(node, content)+".", symbolName+".")
+	}
+	if langName == "dockerfile" && node.Type() == "param" {
+		// Stages are referenced by flags such as COPY --from=build
+		_, value, _ := strings.Cut(node.Content(content), "=")
+		return value == symbolName
+	}
+	if langName == "elixir" && node.Type() == "alias" {
+		text := node.Content(content)
+		return text == symbolName || strings.HasSuffix(text, "."+symbolName)
+	}
+	return isIdentifierNode(node, langName) && node.Content(content) == symbolName
+}
+
+// hclTraversal returns the dotted traversal starting at an HCL variable
+// expression, up to the first index or splat ("module.vpc.subnets[0].id" -> "module.vpc.subnets")
+func hclTraversal(node *sitter.Node, content []byte) string {
+	parts := []string{node.Content(content)}
+	for next := node.NextNamedSibling(); next != nil && next.Type() == "get_attr"; next = next.NextNamedSibling() {
+		if id := next.NamedChild(0); id != nil {
+			parts = append(parts, id.Content(content))
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// isDockerfileArgName reports whether a node is the name of an ARG instruction
+func isDockerfileArgName(node *sitter.Node) bool {
+	parent := node.Parent()
+	if parent == nil || parent.Type() != "arg_instruction" {
+		return false
+	}
+	name := parent.ChildByFieldName("name")
+	return name != nil && name.StartByte() == node.StartByte()
+}
+
 // isIdentifierNode checks if a node is an identifier in the given language
 func isIdentifierNode(node *sitter.Node, langName string) bool {
 	nodeType := node.Type()
@@ -249,6 +366,35 @@ func isIdentifierNode(node *sitter.Node, langName string) bool {
 		return nodeType == "identifier" ||
 			nodeType == "type_identifier" ||
 			nodeType == "field_identifier"
+	case "java":
+		return nodeType == "identifier" ||
+			nodeType == "type_identifier"
+	case "c", "cpp":
+		return nodeType == "identifier" ||
+			nodeType == "type_identifier" ||
+			nodeType == "field_identifier" ||
+			nodeType == "namespace_identifier"
+	case "csharp":
+		return nodeType == "identifier"
+	case "php":
+		return nodeType == "name"
+	case "kotlin", "swift":
+		return nodeType == "simple_identifier" ||
+			nodeType == "type_identifier"
+	case "ruby":
+		return nodeType == "identifier" ||
+			nodeType == "constant"
+	case "bash":
+		return nodeType == "word" ||
+			nodeType == "variable_name"
+	case "scala":
+		return nodeType == "identifier" ||
+			nodeType == "type_identifier"
+	case "dockerfile":
+		return nodeType == "image_alias" ||
+			nodeType == "image_name" ||
+			nodeType == "variable" ||
+			isDockerfileArgName(node)
 	default:
 		return nodeType == "identifier"
 	}
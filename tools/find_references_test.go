@@ -6,7 +6,20 @@ import (
 	"testing"
 
 	// Import Go language parser for tests
+	_ "github.com/roveo/topo-mcp/languages/bash"
+	_ "github.com/roveo/topo-mcp/languages/csharp"
+	_ "github.com/roveo/topo-mcp/languages/dockerfile"
+	_ "github.com/roveo/topo-mcp/languages/elixir"
 	_ "github.com/roveo/topo-mcp/languages/golang"
+	_ "github.com/roveo/topo-mcp/languages/hcl"
+	_ "github.com/roveo/topo-mcp/languages/java"
+	_ "github.com/roveo/topo-mcp/languages/kotlin"
+	_ "github.com/roveo/topo-mcp/languages/php"
+	_ "github.com/roveo/topo-mcp/languages/protobuf"
+	_ "github.com/roveo/topo-mcp/languages/ruby"
+	_ "github.com/roveo/topo-mcp/languages/scala"
+	_ "github.com/roveo/topo-mcp/languages/sql"
+	_ "github.com/roveo/topo-mcp/languages/swift"
 )
 
 func TestFindReferences(t *testing.T) {
@@ -172,6 +185,480 @@ func (p *Person) Greet() string {
 	}
 }
 
+func TestFindReferences_Java(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	src := `package app;
+
+class Person {
+    String name;
+
+    static Person of(String name) {
+        Person p = new Person();
+        p.name = name;
+        return p;
+    }
+}
+`
+	err := os.WriteFile(filepath.Join(tmpDir, "Person.java"), []byte(src), 0o644)
+	if err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	refs, err := FindReferences(tmpDir, "Person")
+	if err != nil {
+		t.Fatalf("FindReferences error: %v", err)
+	}
+
+	// Class name, return type, local variable type and constructor call
+	if len(refs) != 4 {
+		t.Errorf("expected 4 references to Person, got %d", len(refs))
+		for _, ref := range refs {
+			t.Logf("  %s:%d:%d %s", ref.File, ref.Line, ref.Column, ref.Context)
+		}
+	}
+
+	refs, err = FindReferences(tmpDir, "name")
+	if err != nil {
+		t.Fatalf("FindReferences error: %v", err)
+	}
+	if len(refs) != 4 {
+		t.Errorf("expected 4 references to name, got %d", len(refs))
+	}
+}
+
+func TestFindReferences_CSharp(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	src := `namespace App;
+
+public class Person
+{
+    public string Name { get; set; }
+
+    public static Person Create(string name) => new Person { Name = name };
+}
+`
+	err := os.WriteFile(filepath.Join(tmpDir, "Person.cs"), []byte(src), 0o644)
+	if err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	refs, err := FindReferences(tmpDir, "Person")
+	if err != nil {
+		t.Fatalf("FindReferences error: %v", err)
+	}
+
+	// Class name, return type and object creation
+	if len(refs) != 3 {
+		t.Errorf("expected 3 references to Person, got %d", len(refs))
+		for _, ref := range refs {
+			t.Logf("  %s:%d:%d %s", ref.File, ref.Line, ref.Column, ref.Context)
+		}
+	}
+}
+
+func TestFindReferences_Ruby(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	src := `class Invoice
+  def self.build
+    Invoice.new
+  end
+
+  def total
+    amount
+  end
+end
+`
+	err := os.WriteFile(filepath.Join(tmpDir, "invoice.rb"), []byte(src), 0o644)
+	if err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	refs, err := FindReferences(tmpDir, "Invoice")
+	if err != nil {
+		t.Fatalf("FindReferences error: %v", err)
+	}
+
+	// Class name and constructor call
+	if len(refs) != 2 {
+		t.Errorf("expected 2 references to Invoice, got %d", len(refs))
+		for _, ref := range refs {
+			t.Logf("  %s:%d:%d %s", ref.File, ref.Line, ref.Column, ref.Context)
+		}
+	}
+}
+
+func TestFindReferences_Bash(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	src := `#!/usr/bin/env bash
+readonly TARGET=prod
+
+cleanup() {
+  rm -rf "/tmp/$TARGET"
+}
+
+trap cleanup EXIT
+cleanup
+`
+	// Extensionless scripts are recognized by their shebang
+	err := os.WriteFile(filepath.Join(tmpDir, "deploy"), []byte(src), 0o755)
+	if err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	refs, err := FindReferences(tmpDir, "cleanup")
+	if err != nil {
+		t.Fatalf("FindReferences error: %v", err)
+	}
+
+	// Definition, trap handler and call
+	if len(refs) != 3 {
+		t.Errorf("expected 3 references to cleanup, got %d", len(refs))
+		for _, ref := range refs {
+			t.Logf("  %s:%d:%d %s", ref.File, ref.Line, ref.Column, ref.Context)
+		}
+	}
+
+	refs, err = FindReferences(tmpDir, "TARGET")
+	if err != nil {
+		t.Fatalf("FindReferences error: %v", err)
+	}
+	if len(refs) != 2 {
+		t.Errorf("expected 2 references to TARGET, got %d", len(refs))
+	}
+}
+
+func TestFindReferences_SQL(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"001_users.sql": `CREATE TABLE users (
+    id BIGINT PRIMARY KEY,
+    email TEXT NOT NULL
+);
+`,
+		"002_email_index.sql": `ALTER TABLE users ADD COLUMN email_verified BOOLEAN;
+CREATE INDEX idx_users_email ON users (email);
+`,
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(src), 0o644); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+	}
+
+	refs, err := FindReferences(tmpDir, "users")
+	if err != nil {
+		t.Fatalf("FindReferences error: %v", err)
+	}
+
+	// Table definition, ALTER TABLE and index
+	if len(refs) != 3 {
+		t.Errorf("expected 3 references to users, got %d", len(refs))
+		for _, ref := range refs {
+			t.Logf("  %s:%d:%d %s", ref.File, ref.Line, ref.Column, ref.Context)
+		}
+	}
+}
+
+func TestFindReferences_Protobuf(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	files := map[string]string{
+		"invoice.proto": `syntax = "proto3";
+
+message Invoice {
+  string id = 1;
+}
+`,
+		"service.proto": `syntax = "proto3";
+
+import "invoice.proto";
+
+message ListInvoicesResponse {
+  repeated Invoice invoices = 1;
+}
+
+service InvoiceService {
+  rpc GetInvoice(GetInvoiceRequest) returns (Invoice);
+}
+`,
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(src), 0o644); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+	}
+
+	refs, err := FindReferences(tmpDir, "Invoice")
+	if err != nil {
+		t.Fatalf("FindReferences error: %v", err)
+	}
+
+	// Message definition, field type and rpc response type
+	if len(refs) != 3 {
+		t.Errorf("expected 3 references to Invoice, got %d", len(refs))
+		for _, ref := range refs {
+			t.Logf("  %s:%d:%d %s", ref.File, ref.Line, ref.Column, ref.Context)
+		}
+	}
+}
+
+func TestFindReferences_HCL(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	src := `variable "region" {
+  type = string
+}
+
+provider "aws" {
+  region = var.region
+}
+
+module "vpc" {
+  source = "./modules/vpc"
+  region = var.region
+}
+
+output "vpc_id" {
+  value = module.vpc.id
+}
+
+output "subnet_id" {
+  value = module.vpc.subnets[0].id
+}
+`
+	err := os.WriteFile(filepath.Join(tmpDir, "main.tf"), []byte(src), 0o644)
+	if err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	tests := []struct {
+		symbol string
+		want   int
+	}{
+		{"var.region", 2},
+		{"module.vpc", 2},
+		{"module.vpc.id", 1},
+		{"region", 4}, // Plain names still match identifiers (attribute names and var.region)
+	}
+	for _, tt := range tests {
+		refs, err := FindReferences(tmpDir, tt.symbol)
+		if err != nil {
+			t.Fatalf("FindReferences error: %v", err)
+		}
+		if len(refs) != tt.want {
+			t.Errorf("expected %d references to %s, got %d", tt.want, tt.symbol, len(refs))
+			for _, ref := range refs {
+				t.Logf("  %s:%d:%d %s", ref.File, ref.Line, ref.Column, ref.Context)
+			}
+		}
+	}
+}
+
+func TestFindReferences_Elixir(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	src := `defmodule Shop.Cart do
+  alias Shop.Item
+
+  def add(cart, %Item{} = item), do: [item | cart]
+
+  def total(cart), do: Enum.sum(for item <- cart, do: Item.price(item))
+end
+`
+	err := os.WriteFile(filepath.Join(tmpDir, "cart.ex"), []byte(src), 0o644)
+	if err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	refs, err := FindReferences(tmpDir, "Item")
+	if err != nil {
+		t.Fatalf("FindReferences error: %v", err)
+	}
+
+	// Alias, struct pattern and remote call
+	if len(refs) != 3 {
+		t.Errorf("expected 3 references to Item, got %d", len(refs))
+		for _, ref := range refs {
+			t.Logf("  %s:%d:%d %s", ref.File, ref.Line, ref.Column, ref.Context)
+		}
+	}
+}
+
+func TestFindReferences_Scala(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	src := `case class Item(sku: String, price: BigDecimal)
+
+class Cart(val items: List[Item]) {
+  def total: BigDecimal = items.map(_.price).sum
+
+  def add(item: Item): Cart = new Cart(item :: items)
+}
+`
+	err := os.WriteFile(filepath.Join(tmpDir, "Cart.scala"), []byte(src), 0o644)
+	if err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	refs, err := FindReferences(tmpDir, "Item")
+	if err != nil {
+		t.Fatalf("FindReferences error: %v", err)
+	}
+
+	// Class name, type argument and parameter type
+	if len(refs) != 3 {
+		t.Errorf("expected 3 references to Item, got %d", len(refs))
+		for _, ref := range refs {
+			t.Logf("  %s:%d:%d %s", ref.File, ref.Line, ref.Column, ref.Context)
+		}
+	}
+
+	refs, err = FindReferences(tmpDir, "items")
+	if err != nil {
+		t.Fatalf("FindReferences error: %v", err)
+	}
+
+	// Class parameter and two uses
+	if len(refs) != 3 {
+		t.Errorf("expected 3 references to items, got %d", len(refs))
+	}
+}
+
+func TestFindReferences_Dockerfile(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	src := `ARG GO_VERSION=1.25
+
+FROM golang:${GO_VERSION} AS build
+RUN go build -o /bin/app .
+
+FROM build AS test
+RUN go test ./...
+
+FROM gcr.io/distroless/static
+COPY --from=build /bin/app /app
+`
+	err := os.WriteFile(filepath.Join(tmpDir, "Dockerfile"), []byte(src), 0o644)
+	if err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	refs, err := FindReferences(tmpDir, "build")
+	if err != nil {
+		t.Fatalf("FindReferences error: %v", err)
+	}
+
+	// Stage alias, base image of the test stage and COPY --from
+	if len(refs) != 3 {
+		t.Errorf("expected 3 references to build, got %d", len(refs))
+		for _, ref := range refs {
+			t.Logf("  %s:%d:%d %s", ref.File, ref.Line, ref.Column, ref.Context)
+		}
+	}
+
+	refs, err = FindReferences(tmpDir, "GO_VERSION")
+	if err != nil {
+		t.Fatalf("FindReferences error: %v", err)
+	}
+
+	// ARG declaration and expansion
+	if len(refs) != 2 {
+		t.Errorf("expected 2 references to GO_VERSION, got %d", len(refs))
+	}
+}
+
+func TestFindReferences_PHP(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	src := `<?php
+class Cart
+{
+    private array $items = [];
+
+    public static function create(): Cart
+    {
+        return new Cart();
+    }
+
+    public function count(): int
+    {
+        return count($this->items);
+    }
+}
+`
+	err := os.WriteFile(filepath.Join(tmpDir, "cart.php"), []byte(src), 0o644)
+	if err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	refs, err := FindReferences(tmpDir, "Cart")
+	if err != nil {
+		t.Fatalf("FindReferences error: %v", err)
+	}
+
+	// Class name, return type and object creation
+	if len(refs) != 3 {
+		t.Errorf("expected 3 references to Cart, got %d", len(refs))
+		for _, ref := range refs {
+			t.Logf("  %s:%d:%d %s", ref.File, ref.Line, ref.Column, ref.Context)
+		}
+	}
+
+	refs, err = FindReferences(tmpDir, "items")
+	if err != nil {
+		t.Fatalf("FindReferences error: %v", err)
+	}
+
+	// Property declaration and property access
+	if len(refs) != 2 {
+		t.Errorf("expected 2 references to items, got %d", len(refs))
+	}
+}
+
+func TestFindReferences_KotlinAndSwift(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	kt := `data class User(val id: Int) {
+    companion object {
+        fun guest(): User = User(0)
+    }
+}
+`
+	swift := `struct User {
+    let id: Int
+}
+
+extension User {
+    static let guest = User(id: 0)
+}
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "User.kt"), []byte(kt), 0o644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "User.swift"), []byte(swift), 0o644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	refs, err := FindReferences(tmpDir, "User")
+	if err != nil {
+		t.Fatalf("FindReferences error: %v", err)
+	}
+
+	// Kotlin: class name, return type and constructor call;
+	// Swift: struct name, extended type and initializer call
+	if len(refs) != 6 {
+		t.Errorf("expected 6 references to User, got %d", len(refs))
+		for _, ref := range refs {
+			t.Logf("  %s:%d:%d %s", ref.File, ref.Line, ref.Column, ref.Context)
+		}
+	}
+}
+
 func TestFindReferences_Subdirectories(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -226,3 +713,37 @@ func Shared() {
 		}
 	}
 }
+
+func TestFindReferences_EnclosingSymbol(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	src := `package main
+
+type Server struct{}
+
+func (s *Server) Start() {
+	Hello("server")
+}
+
+var greeting = Hello("var")
+`
+	err := os.WriteFile(filepath.Join(tmpDir, "server.go"), []byte(src), 0o644)
+	if err != nil {
+		t.Fatalf("failed to write server.go: %v", err)
+	}
+
+	refs, err := FindReferences(tmpDir, "Hello")
+	if err != nil {
+		t.Fatalf("FindReferences error: %v", err)
+	}
+	if len(refs) != 2 {
+		t.Fatalf("expected 2 references, got %d", len(refs))
+	}
+
+	want := []string{"topo://server.go#(*Server).Start", "topo://server.go#greeting"}
+	for i, ref := range refs {
+		if ref.Symbol != want[i] {
+			t.Errorf("refs[%d].Symbol = %q, want %q", i, ref.Symbol, want[i])
+		}
+	}
+}
@@ -8,12 +8,13 @@ import (
 	"strings"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/roveo/topo-mcp/languages"
 )
 
 // ReadDefinitionInput is the input schema for the read_definition tool
 type ReadDefinitionInput struct {
-	File   string `json:"file" jsonschema_description:"Relative file path from the project root (e.g., 'cmd/main.go', 'src/utils.py')."`
-	Symbol string `json:"symbol" jsonschema_description:"Name of the symbol to retrieve (function, type, class, method, etc.). For methods, use just the method name without the receiver."`
+	File   string `json:"file,omitempty" jsonschema_description:"Relative file path from the project root (e.g., 'cmd/main.go', 'src/utils.py'). May be omitted when symbol is a topo:// ID."`
+	Symbol string `json:"symbol" jsonschema_description:"Name of the symbol to retrieve (function, type, class, method, etc.). Nested symbols are addressed by path (e.g., 'Server.handle_request', or 'Usage/CLI Mode' for Markdown sections). Methods can be qualified by receiver (e.g., 'Server.Start', '(*Server).Start', 'impl Display for Foo::fmt'). Append '#N' to pick the Nth of several same-named symbols (e.g., 'String#2'). Ambiguous names return an error listing every candidate."`
 }
 
 // ReadDefinitionTool creates the read_definition MCP tool
@@ -22,41 +23,46 @@ func ReadDefinitionTool() *mcp.Tool {
 		Name: "read_definition",
 		Description: `Get the complete source code of a symbol (function, type, class, etc.) by file path and name.
 
-Get file path and symbol name from 'index' output, or use directly if you already know them.
+Get file path and symbol name from 'index' output, or use directly if you already know them. A symbol ID (topo://<file>#<symbol>) from 'index' or 'find_references' can be passed as the symbol without a file.
 
 Returns the full implementation with line numbers. More efficient than reading entire files when you only need one symbol.`,
 	}
 }
 
 // ReadDefinitionHandler handles the read_definition tool invocation
-func ReadDefinitionHandler(cfg *Config) func(context.Context, *mcp.CallToolRequest, ReadDefinitionInput) (*mcp.CallToolResult, any, error) {
-	return func(ctx context.Context, req *mcp.CallToolRequest, input ReadDefinitionInput) (*mcp.CallToolResult, any, error) {
-		if input.File == "" {
-			return nil, nil, fmt.Errorf("file path is required")
-		}
+func ReadDefinitionHandler(cfg *Config) func(context.Context, *mcp.CallToolRequest, ReadDefinitionInput) (*mcp.CallToolResult, ReadDefinitionOutput, error) {
+	return func(ctx context.Context, req *mcp.CallToolRequest, input ReadDefinitionInput) (*mcp.CallToolResult, ReadDefinitionOutput, error) {
 		if input.Symbol == "" {
-			return nil, nil, fmt.Errorf("symbol name is required")
+			return nil, ReadDefinitionOutput{}, fmt.Errorf("symbol name is required")
+		}
+		file, selector, err := resolveSymbolArg(input.File, input.Symbol)
+		if err != nil {
+			return nil, ReadDefinitionOutput{}, err
 		}
 
 		// Make path absolute if relative
-		filePath := input.File
+		filePath := file
 		if !filepath.IsAbs(filePath) {
 			cwd, err := os.Getwd()
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to get working directory: %w", err)
+				return nil, ReadDefinitionOutput{}, fmt.Errorf("failed to get working directory: %w", err)
 			}
 			filePath = filepath.Join(cwd, filePath)
 		}
 
 		// Check if file exists
 		if _, err := os.Stat(filePath); os.IsNotExist(err) {
-			return nil, nil, fmt.Errorf("file not found: %s", input.File)
+			return nil, ReadDefinitionOutput{}, fmt.Errorf("file not found: %s", file)
 		}
 
 		// Find the symbol and get its source code
-		symbol, lines, err := FindSymbol(filePath, input.Symbol)
+		symbol, symbols, lines, err := findSymbol(filePath, selector)
+		if err != nil {
+			return nil, ReadDefinitionOutput{}, err
+		}
+		idFile, err := rootRelative(filePath)
 		if err != nil {
-			return nil, nil, err
+			return nil, ReadDefinitionOutput{}, err
 		}
 
 		// Format the output
@@ -65,10 +71,12 @@ func ReadDefinitionHandler(cfg *Config) func(context.Context, *mcp.CallToolReque
 		endLine := loc.End.Line + 1
 
 		var sb strings.Builder
-		sb.WriteString(fmt.Sprintf("# %s in %s [%d-%d]\n\n", symbol.String(), input.File, startLine, endLine))
+		sb.WriteString(fmt.Sprintf("# %s in %s [%d-%d]\n", symbol.String(), file, startLine, endLine))
+		id := symbolIDOf(idFile, symbols, symbol)
+		sb.WriteString(id + "\n\n")
 
 		// Add doc comment if available
-		if doc, ok := symbol.(interface{ DocComment() string }); ok {
+		if doc, ok := symbol.(languages.Documented); ok {
 			if docStr := doc.DocComment(); docStr != "" {
 				sb.WriteString(fmt.Sprintf("// %s\n\n", docStr))
 			}
@@ -86,6 +94,10 @@ func ReadDefinitionHandler(cfg *Config) func(context.Context, *mcp.CallToolReque
 			Content: []mcp.Content{
 				&mcp.TextContent{Text: sb.String()},
 			},
-		}, nil, nil
+		}, ReadDefinitionOutput{
+			File:   filepath.ToSlash(file),
+			Symbol: symbolResult(symbol, id),
+			Code:   strings.Join(lines, "\n"),
+		}, nil
 	}
 }
@@ -1,13 +1,18 @@
 package tools
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 
-	// Import Go language parser for tests
+	// Import language parsers for tests
 	_ "github.com/roveo/topo-mcp/languages/golang"
+	_ "github.com/roveo/topo-mcp/languages/python"
+	_ "github.com/roveo/topo-mcp/languages/rust"
+	_ "github.com/roveo/topo-mcp/languages/sql"
+	_ "github.com/roveo/topo-mcp/languages/swift"
 )
 
 func TestFindSymbol(t *testing.T) {
@@ -152,3 +157,172 @@ var X = 1
 		}
 	}
 }
+
+func TestFindSymbol_QualifiedMember(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "server.py")
+	content := `class Server:
+    def start(self):
+        pass
+
+    def stop(self):
+        self.running = False
+        pass
+`
+	err := os.WriteFile(testFile, []byte(content), 0o644)
+	if err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	sym, lines, err := FindSymbol(testFile, "Server.stop")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sym.Name() != "stop" {
+		t.Errorf("symbol name = %q, want %q", sym.Name(), "stop")
+	}
+	if len(lines) != 3 {
+		t.Errorf("got %d lines, want 3\nlines: %v", len(lines), lines)
+	}
+
+	// Members are addressed through their class
+	if _, _, err := FindSymbol(testFile, "Server.missing"); err == nil {
+		t.Error("expected error for missing member")
+	}
+}
+
+func TestFindSymbol_AmbiguousMethods(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "server.go")
+	content := `package main
+
+type Server struct{}
+
+type Client struct{}
+
+func (s *Server) String() string {
+	return "server"
+}
+
+func (c Client) String() string {
+	return "client"
+}
+`
+	err := os.WriteFile(testFile, []byte(content), 0o644)
+	if err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	// A bare name matching several symbols lists every candidate
+	_, _, err = FindSymbol(testFile, "String")
+	var ambiguous *AmbiguousSymbolError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("expected AmbiguousSymbolError, got %v", err)
+	}
+	if len(ambiguous.Candidates) != 2 {
+		t.Errorf("got %d candidates, want 2", len(ambiguous.Candidates))
+	}
+	for _, want := range []string{"(*Server).String", "[7-9]", "(Client).String", "[11-13]"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error missing %q:\n%s", want, err)
+		}
+	}
+
+	tests := []struct {
+		selector  string
+		wantStart int
+	}{
+		{"Server.String", 6},
+		{"(*Server).String", 6},
+		{"Client.String", 10},
+		{"(Client).String", 10},
+		{"String#2", 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			sym, _, err := FindSymbol(testFile, tt.selector)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if sym.Location().Start.Line != tt.wantStart {
+				t.Errorf("start line = %d, want %d", sym.Location().Start.Line, tt.wantStart)
+			}
+		})
+	}
+
+	if _, _, err := FindSymbol(testFile, "String#3"); err == nil {
+		t.Error("expected error for out-of-range ordinal")
+	}
+	if _, _, err := FindSymbol(testFile, "(Server).String"); err == nil {
+		t.Error("expected error for mismatched receiver")
+	}
+}
+
+func TestFindSymbol_ExtensionsDontShadowType(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"shapes.rs": `pub struct Circle {
+    r: f64,
+}
+
+impl Circle {
+    pub fn area(&self) -> f64 { 3.14 * self.r * self.r }
+}
+
+impl fmt::Display for Circle {
+    fn fmt(&self, f: &mut fmt::Formatter) -> fmt::Result { Ok(()) }
+}
+`,
+		"User.swift": `struct User {
+    let id: Int
+}
+
+extension User: Equatable {
+    var isNew: Bool { id == 0 }
+}
+`,
+		"002_users.sql": `CREATE TABLE users (
+    id BIGSERIAL PRIMARY KEY
+);
+
+ALTER TABLE users ADD COLUMN email TEXT;
+`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	tests := []struct {
+		file      string
+		selector  string
+		wantKind  string
+		wantStart int
+	}{
+		{"shapes.rs", "Circle", "struct", 0},
+		{"shapes.rs", "Circle.area", "method", 5},
+		{"shapes.rs", "impl Circle", "impl", 4},
+		{"shapes.rs", "impl fmt::Display for Circle", "impl", 8},
+		{"shapes.rs", "impl Display for Circle", "impl", 8},
+		{"User.swift", "User", "struct", 0},
+		{"User.swift", "extension User", "extension", 4},
+		{"User.swift", "User.isNew", "property", 5},
+		{"002_users.sql", "users", "table", 0},
+		{"002_users.sql", "alter table users", "alter_table", 4},
+		{"002_users.sql", "users.email", "column", 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			sym, _, err := FindSymbol(filepath.Join(tmpDir, tt.file), tt.selector)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if sym.Kind() != tt.wantKind || sym.Location().Start.Line != tt.wantStart {
+				t.Errorf("got %s at line %d, want %s at line %d", sym.Kind(), sym.Location().Start.Line, tt.wantKind, tt.wantStart)
+			}
+		})
+	}
+}
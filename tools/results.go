@@ -0,0 +1,127 @@
+package tools
+
+import (
+	"path/filepath"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// Structured tool results. They are returned as MCP structured content next to
+// the text rendering, and their output schemas are derived from these types.
+// Symbols are flattened in source order and linked through their parent ID,
+// since output schemas can't be recursive.
+
+// PositionResult is a 1-based position in a file
+type PositionResult struct {
+	Line   int `json:"line" jsonschema_description:"1-based line number"`
+	Column int `json:"column" jsonschema_description:"1-based column (byte offset in the line plus one)"`
+}
+
+// RangeResult is a 1-based range in a file. The end position is exclusive.
+type RangeResult struct {
+	Start PositionResult `json:"start"`
+	End   PositionResult `json:"end"`
+}
+
+// SymbolResult is the structured form of a symbol
+type SymbolResult struct {
+	ID        string      `json:"id" jsonschema_description:"Stable symbol ID (topo://<file>#<symbol>)"`
+	Kind      string      `json:"kind"`
+	Name      string      `json:"name"`
+	Signature string      `json:"signature" jsonschema_description:"Language-specific rendering of the declaration"`
+	Doc       string      `json:"doc,omitempty" jsonschema_description:"First line of the doc comment"`
+	Parent    string      `json:"parent,omitempty" jsonschema_description:"ID of the enclosing symbol"`
+	Range     RangeResult `json:"range"`
+}
+
+// FileResult is the structured form of an indexed file
+type FileResult struct {
+	Path      string         `json:"path"`
+	Language  string         `json:"language"`
+	Imports   []string       `json:"imports,omitempty"`
+	Symbols   []SymbolResult `json:"symbols,omitempty"`
+	Truncated bool           `json:"truncated,omitempty" jsonschema_description:"Symbols omitted to stay within the line limit"`
+	Skipped   bool           `json:"skipped,omitempty" jsonschema_description:"Skipped by default; use filter to index it"`
+}
+
+// IndexOutput is the structured result of the index tool
+type IndexOutput struct {
+	Files []FileResult `json:"files"`
+}
+
+// ReadDefinitionOutput is the structured result of the read_definition tool
+type ReadDefinitionOutput struct {
+	File   string       `json:"file"`
+	Symbol SymbolResult `json:"symbol"`
+	Code   string       `json:"code" jsonschema_description:"Source code of the symbol"`
+}
+
+// WriteDefinitionOutput is the structured result of the write_definition tool
+type WriteDefinitionOutput struct {
+	File   string       `json:"file"`
+	Symbol SymbolResult `json:"symbol" jsonschema_description:"The symbol as it was before the replacement"`
+	Lines  RangeResult  `json:"lines" jsonschema_description:"Lines now occupied by the new code"`
+}
+
+// FindReferencesOutput is the structured result of the find_references tool
+type FindReferencesOutput struct {
+	Symbol     string      `json:"symbol"`
+	References []Reference `json:"references"`
+}
+
+// toRangeResult converts a 0-based range to a 1-based one
+func toRangeResult(r languages.Range) RangeResult {
+	return RangeResult{
+		Start: PositionResult{Line: r.Start.Line + 1, Column: r.Start.Character + 1},
+		End:   PositionResult{Line: r.End.Line + 1, Column: r.End.Character + 1},
+	}
+}
+
+// symbolResults returns the structured form of every symbol in a file,
+// flattened in source order
+func symbolResults(file string, symbols []languages.Symbol) []SymbolResult {
+	flat := languages.Flatten(symbols)
+	ids := symbolIDs(file, symbols)
+
+	results := make([]SymbolResult, len(flat))
+	parents := make([]int, 0, 8) // Indexes of the enclosing symbols by depth
+	i := 0
+	languages.Walk(symbols, func(sym languages.Symbol, depth int) {
+		parents = append(parents[:depth], i)
+		results[i] = symbolResult(sym, ids[i])
+		if depth > 0 {
+			results[i].Parent = ids[parents[depth-1]]
+		}
+		i++
+	})
+	return results
+}
+
+// symbolResult returns the structured form of a single symbol
+func symbolResult(sym languages.Symbol, id string) SymbolResult {
+	result := SymbolResult{
+		ID:        id,
+		Kind:      sym.Kind(),
+		Name:      sym.Name(),
+		Signature: sym.String(),
+		Range:     toRangeResult(sym.Location()),
+	}
+	if doc, ok := sym.(languages.Documented); ok {
+		result.Doc = doc.DocComment()
+	}
+	return result
+}
+
+// fileResult returns the structured form of an indexed file
+func fileResult(file FileIndex) FileResult {
+	result := FileResult{
+		Path:      filepath.ToSlash(file.Path),
+		Language:  file.Language,
+		Imports:   file.Imports,
+		Truncated: file.Truncated,
+	}
+	if !file.Truncated {
+		result.Symbols = symbolResults(file.Path, file.Symbols)
+	}
+	return result
+}
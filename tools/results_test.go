@@ -0,0 +1,212 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/roveo/topo-mcp/languages"
+)
+
+func TestOutputSchemas(t *testing.T) {
+	// AddTool panics if an output schema can't be derived from the result type
+	s := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "0"}, nil)
+	cfg := &Config{}
+	mcp.AddTool(s, CodemapTool(), CodemapHandler(cfg))
+	mcp.AddTool(s, ReadDefinitionTool(), ReadDefinitionHandler(cfg))
+	mcp.AddTool(s, WriteDefinitionTool(), WriteDefinitionHandler(cfg))
+	mcp.AddTool(s, FindReferencesTool(), FindReferencesHandler(cfg))
+}
+
+func TestSymbolResults(t *testing.T) {
+	class := mockContainer{
+		mockSymbol: mockSymbol{symbolName: "Server", symbolKind: "class", loc: languages.Range{
+			Start: languages.Position{Line: 0},
+			End:   languages.Position{Line: 9, Character: 12},
+		}},
+		children: []languages.Symbol{
+			mockSymbol{symbolName: "start", symbolKind: "method", loc: languages.Range{
+				Start: languages.Position{Line: 2, Character: 4},
+				End:   languages.Position{Line: 4, Character: 8},
+			}},
+		},
+	}
+	symbols := []languages.Symbol{class, mockSymbol{symbolName: "main", symbolKind: "func"}}
+
+	results := symbolResults("server.py", symbols)
+	if len(results) != 3 {
+		t.Fatalf("expected 3 symbols, got %d", len(results))
+	}
+
+	method := results[1]
+	if method.ID != "topo://server.py#start" || method.Parent != "topo://server.py#Server" {
+		t.Errorf("method ID/parent = %q/%q", method.ID, method.Parent)
+	}
+	if method.Kind != "method" || method.Name != "start" || method.Signature != "method start" {
+		t.Errorf("unexpected method result: %+v", method)
+	}
+	want := RangeResult{Start: PositionResult{Line: 3, Column: 5}, End: PositionResult{Line: 5, Column: 9}}
+	if method.Range != want {
+		t.Errorf("method range = %+v, want %+v", method.Range, want)
+	}
+	if results[2].Parent != "" {
+		t.Errorf("top-level symbol has parent %q", results[2].Parent)
+	}
+}
+
+func TestCodemapResult(t *testing.T) {
+	files := makeTestFilesInDirs([]string{"", "vendor/lib"}, 2)
+
+	output := CodemapResult(files, FormatOptions{SkipPatterns: []string{"vendor"}})
+	if len(output.Files) != 2 {
+		t.Fatalf("expected 2 files, got %d", len(output.Files))
+	}
+	if !output.Files[0].Skipped || output.Files[0].Path != "vendor/lib/main.go" {
+		t.Errorf("expected skipped vendor file first, got %+v", output.Files[0])
+	}
+	if output.Files[1].Path != "main.go" || len(output.Files[1].Symbols) != 2 {
+		t.Errorf("unexpected main.go result: %+v", output.Files[1])
+	}
+
+	// Empty results still marshal as an array
+	data, err := json.Marshal(CodemapResult(nil, FormatOptions{}))
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+	if string(data) != `{"files":[]}` {
+		t.Errorf("got %s", data)
+	}
+}
+
+func TestReadDefinitionHandler_Structured(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "hello.go")
+	content := `package main
+
+// Hello returns a greeting
+func Hello(name string) string {
+	return "Hello, " + name
+}
+`
+	if err := os.WriteFile(testFile, []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	handler := ReadDefinitionHandler(&Config{})
+	_, output, err := handler(context.Background(), nil, ReadDefinitionInput{File: testFile, Symbol: "Hello"})
+	if err != nil {
+		t.Fatalf("handler error: %v", err)
+	}
+
+	if output.Symbol.Kind != "func" || output.Symbol.Doc != "Hello returns a greeting" {
+		t.Errorf("unexpected symbol result: %+v", output.Symbol)
+	}
+	if output.Symbol.Range.Start.Line != 4 || output.Symbol.Range.End.Line != 6 {
+		t.Errorf("unexpected range: %+v", output.Symbol.Range)
+	}
+	wantCode := "func Hello(name string) string {\n\treturn \"Hello, \" + name\n}"
+	if output.Code != wantCode {
+		t.Errorf("code = %q, want %q", output.Code, wantCode)
+	}
+}
+
+func TestWriteDefinitionHandler_Structured(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "hello.go")
+	content := `package main
+
+func Hello() string {
+	return "Hello"
+}
+`
+	if err := os.WriteFile(testFile, []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	handler := WriteDefinitionHandler(&Config{})
+	_, output, err := handler(context.Background(), nil, WriteDefinitionInput{
+		File:   testFile,
+		Symbol: "Hello",
+		Code:   "func Hello() string {\n\tgreeting := \"Hi\"\n\treturn greeting\n}",
+	})
+	if err != nil {
+		t.Fatalf("handler error: %v", err)
+	}
+
+	want := RangeResult{Start: PositionResult{Line: 3, Column: 1}, End: PositionResult{Line: 6, Column: 2}}
+	if output.Lines != want {
+		t.Errorf("lines = %+v, want %+v", output.Lines, want)
+	}
+	if output.Symbol.Range.End.Line != 5 {
+		t.Errorf("expected the replaced symbol's old range, got %+v", output.Symbol.Range)
+	}
+}
+
+func TestSymbolIDs_RoundTripFromSubdirectory(t *testing.T) {
+	root := t.TempDir()
+	writeFile := func(path, content string) {
+		path = filepath.Join(root, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("failed to write test file: %v", err)
+		}
+	}
+	writeFile("sub/server.go", `package sub
+
+type Server struct{}
+
+func (s *Server) Start() {}
+`)
+	writeFile("sub/main.go", `package sub
+
+func run() {
+	(&Server{}).Start()
+}
+`)
+	t.Chdir(root)
+
+	_, index, err := CodemapHandler(&Config{})(context.Background(), nil, CodemapInput{Path: "sub", Filter: "server.go"})
+	if err != nil {
+		t.Fatalf("index error: %v", err)
+	}
+	if len(index.Files) != 1 || index.Files[0].Path != "sub/server.go" {
+		t.Fatalf("expected only sub/server.go, got %+v", index.Files)
+	}
+	id := index.Files[0].Symbols[1].ID
+	if id != "topo://sub/server.go#(*Server).Start" {
+		t.Errorf("unexpected ID %q", id)
+	}
+
+	// An ID from index resolves in read_definition and find_references
+	_, read, err := ReadDefinitionHandler(&Config{})(context.Background(), nil, ReadDefinitionInput{Symbol: id})
+	if err != nil {
+		t.Fatalf("read_definition error: %v", err)
+	}
+	if read.Symbol.ID != id {
+		t.Errorf("read_definition returned ID %q, want %q", read.Symbol.ID, id)
+	}
+
+	_, refs, err := FindReferencesHandler(&Config{})(context.Background(), nil, FindReferencesInput{Path: "sub", Symbol: id})
+	if err != nil {
+		t.Fatalf("find_references error: %v", err)
+	}
+	var caller string
+	for _, ref := range refs.References {
+		if ref.File == "sub/main.go" {
+			caller = ref.Symbol
+		}
+	}
+	if caller != "topo://sub/main.go#run" {
+		t.Fatalf("expected a reference in sub/main.go inside run, got %+v", refs.References)
+	}
+
+	// And the ID of the enclosing symbol resolves in read_definition too
+	if _, _, err := ReadDefinitionHandler(&Config{})(context.Background(), nil, ReadDefinitionInput{Symbol: caller}); err != nil {
+		t.Errorf("read_definition error for %q: %v", caller, err)
+	}
+}
@@ -0,0 +1,138 @@
+package tools
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// SymbolIDScheme prefixes stable symbol identifiers
+// (e.g., "topo://tools/codemap.go#FormatCodemap" or "topo://server.go#(*Server).Start")
+const SymbolIDScheme = "topo://"
+
+// SymbolID builds the identifier of a symbol from a file path and a selector
+func SymbolID(file, selector string) string {
+	return SymbolIDScheme + filepath.ToSlash(file) + "#" + selector
+}
+
+// IsSymbolID reports whether s is a stable symbol identifier rather than a plain selector
+func IsSymbolID(s string) bool {
+	return strings.HasPrefix(s, SymbolIDScheme)
+}
+
+// ParseSymbolID splits a symbol identifier into its file path and selector
+func ParseSymbolID(id string) (file, selector string, err error) {
+	rest, ok := strings.CutPrefix(id, SymbolIDScheme)
+	if !ok {
+		return "", "", fmt.Errorf("invalid symbol ID %q: missing %s prefix", id, SymbolIDScheme)
+	}
+	file, selector, ok = strings.Cut(rest, "#")
+	if !ok || file == "" || selector == "" {
+		return "", "", fmt.Errorf("invalid symbol ID %q: expected %s<file>#<symbol>", id, SymbolIDScheme)
+	}
+	return filepath.FromSlash(file), selector, nil
+}
+
+// rootRelative returns the path of a file or directory as it appears in
+// symbol IDs: relative to the server's working directory, so that an ID minted
+// by any tool resolves the same way in every other. Paths outside the working
+// directory stay absolute.
+func rootRelative(path string) (string, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("failed to get working directory: %w", err)
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(cwd, path)
+	}
+	rel, err := filepath.Rel(cwd, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return path, nil
+	}
+	return rel, nil
+}
+
+// symbolSelectors returns the selector that identifies each symbol in a file,
+// in the order of languages.Flatten. It is the symbol's most specific selector,
+// followed by a "#N" ordinal when other symbols share it.
+func symbolSelectors(symbols []languages.Symbol) []string {
+	flat := languages.Flatten(symbols)
+
+	// Count the symbols addressed by every selector
+	matches := make(map[string]int)
+	for _, sym := range flat {
+		for _, sel := range uniqueSelectors(sym) {
+			matches[sel]++
+		}
+	}
+
+	selectors := make([]string, len(flat))
+	seen := make(map[string]int)
+	for i, sym := range flat {
+		for _, sel := range uniqueSelectors(sym) {
+			seen[sel]++
+		}
+		sel := languages.Selectors(sym)[0]
+		if matches[sel] > 1 {
+			sel = fmt.Sprintf("%s#%d", sel, seen[sel])
+		}
+		selectors[i] = sel
+	}
+	return selectors
+}
+
+// symbolIDs returns the identifier of each symbol in a file, in the order of languages.Flatten
+func symbolIDs(file string, symbols []languages.Symbol) []string {
+	ids := symbolSelectors(symbols)
+	for i, sel := range ids {
+		ids[i] = SymbolID(file, sel)
+	}
+	return ids
+}
+
+// symbolIDOf returns the identifier of target among the symbols of a file
+func symbolIDOf(file string, symbols []languages.Symbol, target languages.Symbol) string {
+	ids := symbolIDs(file, symbols)
+	for i, sym := range languages.Flatten(symbols) {
+		if sym == target {
+			return ids[i]
+		}
+	}
+	return ""
+}
+
+// uniqueSelectors returns a symbol's selectors without duplicates
+func uniqueSelectors(sym languages.Symbol) []string {
+	var selectors []string
+	seen := make(map[string]bool)
+	for _, sel := range languages.Selectors(sym) {
+		if !seen[sel] {
+			seen[sel] = true
+			selectors = append(selectors, sel)
+		}
+	}
+	return selectors
+}
+
+// resolveSymbolArg resolves the file and symbol arguments of a tool call.
+// The symbol may be a stable ID, in which case it carries the file itself
+// and the file argument may be omitted.
+func resolveSymbolArg(file, symbol string) (string, string, error) {
+	if !IsSymbolID(symbol) {
+		if file == "" {
+			return "", "", fmt.Errorf("file path is required")
+		}
+		return file, symbol, nil
+	}
+	idFile, selector, err := ParseSymbolID(symbol)
+	if err != nil {
+		return "", "", err
+	}
+	if file != "" && filepath.Clean(file) != filepath.Clean(idFile) {
+		return "", "", fmt.Errorf("symbol ID %q does not belong to file %s", symbol, file)
+	}
+	return idFile, selector, nil
+}
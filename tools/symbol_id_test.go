@@ -0,0 +1,177 @@
+package tools
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+func TestParseSymbolID(t *testing.T) {
+	tests := []struct {
+		id           string
+		wantFile     string
+		wantSelector string
+		wantErr      bool
+	}{
+		{"topo://tools/codemap.go#FormatCodemap", filepath.FromSlash("tools/codemap.go"), "FormatCodemap", false},
+		{"topo://server.go#(*Server).Start", "server.go", "(*Server).Start", false},
+		{"topo://server.go#String#2", "server.go", "String#2", false},
+		{"topo://README.md#Usage/CLI Mode", "README.md", "Usage/CLI Mode", false},
+		{"topo://server.go", "", "", true},
+		{"topo://#Start", "", "", true},
+		{"server.go#Start", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			file, selector, err := ParseSymbolID(tt.id)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got (%q, %q)", file, selector)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if file != tt.wantFile || selector != tt.wantSelector {
+				t.Errorf("ParseSymbolID() = (%q, %q), want (%q, %q)", file, selector, tt.wantFile, tt.wantSelector)
+			}
+		})
+	}
+}
+
+func TestSymbolIDs_RoundTrip(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "server.go")
+	content := `package main
+
+type Server struct {
+	Addr string
+}
+
+func (s *Server) Start() {}
+
+func (s Server) String() string { return "" }
+
+func (s *Server) String() string { return "" }
+`
+	err := os.WriteFile(testFile, []byte(content), 0o644)
+	if err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	symbols, err := ParseFile(testFile)
+	if err != nil {
+		t.Fatalf("ParseFile error: %v", err)
+	}
+
+	ids := symbolIDs("server.go", symbols)
+	want := []string{
+		"topo://server.go#Server",
+		"topo://server.go#Server.Addr",
+		"topo://server.go#(*Server).Start",
+		"topo://server.go#(Server).String",
+		"topo://server.go#(*Server).String",
+	}
+	if len(ids) != len(want) {
+		t.Fatalf("got IDs %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Errorf("ids[%d] = %q, want %q", i, ids[i], want[i])
+		}
+	}
+
+	// Every ID resolves back to the symbol it was built for
+	for i, sym := range languages.Flatten(symbols) {
+		_, selector, err := ParseSymbolID(ids[i])
+		if err != nil {
+			t.Fatalf("ParseSymbolID(%q) error: %v", ids[i], err)
+		}
+		found, _, err := FindSymbol(testFile, selector)
+		if err != nil {
+			t.Fatalf("FindSymbol(%q) error: %v", selector, err)
+		}
+		if found.Location() != sym.Location() {
+			t.Errorf("ID %q resolved to %s, want %s", ids[i], found, sym)
+		}
+	}
+}
+
+func TestSymbolIDs_StableWhenSameNameAdded(t *testing.T) {
+	tests := []struct {
+		file   string
+		before string
+		after  string // before, with unrelated symbols of the same name added
+		want   []string
+	}{
+		{
+			file:   "main.go",
+			before: "package main\n\nfunc String() string { return \"\" }\n",
+			after:  "package main\n\ntype T struct{}\n\nfunc (T) String() string { return \"\" }\n\nfunc String() string { return \"\" }\n\nfunc (*U) String() string { return \"\" }\n",
+			want:   []string{"topo://main.go#String"},
+		},
+		{
+			file:   "shapes.rs",
+			before: "pub struct Circle {\n    r: f64,\n}\n",
+			after:  "impl Circle {\n    fn new() -> Self { todo!() }\n}\n\npub struct Circle {\n    r: f64,\n}\n\nimpl Default for Circle {\n    fn default() -> Self { todo!() }\n}\n",
+			want:   []string{"topo://shapes.rs#Circle"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			path := filepath.Join(tmpDir, tt.file)
+			for i, content := range []string{tt.before, tt.after} {
+				if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+					t.Fatalf("failed to write test file: %v", err)
+				}
+				symbols, err := ParseFile(path)
+				if err != nil {
+					t.Fatalf("ParseFile error: %v", err)
+				}
+				ids := symbolIDs(tt.file, symbols)
+				for _, id := range tt.want {
+					if !slices.Contains(ids, id) {
+						t.Errorf("version %d: missing ID %q, got %v", i, id, ids)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSymbolSelectors_Ordinals(t *testing.T) {
+	symbols := []languages.Symbol{
+		mockSymbol{symbolName: "init", symbolKind: "func"},
+		mockSymbol{symbolName: "main", symbolKind: "func"},
+		mockSymbol{symbolName: "init", symbolKind: "func"},
+	}
+
+	got := symbolSelectors(symbols)
+	want := []string{"init#1", "main", "init#2"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("symbolSelectors()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestResolveSymbolArg(t *testing.T) {
+	file, selector, err := resolveSymbolArg("", "topo://main.go#main")
+	if err != nil || file != "main.go" || selector != "main" {
+		t.Errorf("resolveSymbolArg() = (%q, %q, %v), want (main.go, main, nil)", file, selector, err)
+	}
+
+	if _, _, err := resolveSymbolArg("other.go", "topo://main.go#main"); err == nil {
+		t.Error("expected error for ID from another file")
+	}
+	if _, _, err := resolveSymbolArg("", "main"); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
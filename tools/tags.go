@@ -0,0 +1,244 @@
+package tools
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/roveo/topo-mcp/languages"
+)
+
+// TagsOptions controls how tags files are generated
+type TagsOptions struct {
+	Root         string   // Directory the files were indexed from
+	BaseDir      string   // Directory file names are written relative to (where the tags file lives)
+	SkipPatterns []string // Path prefixes to leave out, as in the index
+}
+
+// tagEntry is a single symbol definition in a tags file
+type tagEntry struct {
+	name      string
+	file      string // Path relative to TagsOptions.BaseDir, slash-separated
+	line      int    // 0-based line number
+	offset    int    // Byte offset of the line start
+	text      string // Content of the definition line
+	kind      string
+	scope     string // "<kind>:<qualified name>" of the enclosing symbol
+	signature string
+	language  string
+}
+
+// WriteCtags writes a sorted tags file in the extended ctags format
+// (see ctags(5)), with kind, scope, signature, line and language fields
+func WriteCtags(w io.Writer, files []FileIndex, opts TagsOptions) error {
+	entries, err := collectTags(files, opts)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].name != entries[j].name {
+			return entries[i].name < entries[j].name
+		}
+		if entries[i].file != entries[j].file {
+			return entries[i].file < entries[j].file
+		}
+		return entries[i].line < entries[j].line
+	})
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString("!_TAG_FILE_FORMAT\t2\t/extended format; --format=1 will not append ;\" to lines/\n")
+	bw.WriteString("!_TAG_FILE_SORTED\t1\t/0=unsorted, 1=sorted, 2=foldcase/\n")
+	bw.WriteString("!_TAG_PROGRAM_NAME\ttopo\t//\n")
+	bw.WriteString("!_TAG_PROGRAM_URL\thttps://github.com/roveo/topo-mcp\t//\n")
+
+	for _, e := range entries {
+		fields := []string{"kind:" + e.kind, fmt.Sprintf("line:%d", e.line+1)}
+		if e.language != "" {
+			fields = append(fields, "language:"+e.language)
+		}
+		if e.scope != "" {
+			fields = append(fields, e.scope)
+		}
+		if e.signature != "" {
+			fields = append(fields, "signature:"+e.signature)
+		}
+		fmt.Fprintf(bw, "%s\t%s\t/^%s$/;\"\t%s\n", e.name, e.file, escapeTagPattern(e.text), strings.Join(fields, "\t"))
+	}
+
+	return bw.Flush()
+}
+
+// WriteEtags writes an Emacs TAGS file with one section per source file
+func WriteEtags(w io.Writer, files []FileIndex, opts TagsOptions) error {
+	entries, err := collectTags(files, opts)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	for len(entries) > 0 {
+		// Entries are grouped by file in index order
+		n := 1
+		for n < len(entries) && entries[n].file == entries[0].file {
+			n++
+		}
+		section := entries[:n]
+		entries = entries[n:]
+
+		sort.SliceStable(section, func(i, j int) bool {
+			return section[i].line < section[j].line
+		})
+
+		var sb strings.Builder
+		for _, e := range section {
+			fmt.Fprintf(&sb, "%s\x7f%s\x01%d,%d\n", e.text, e.name, e.line+1, e.offset)
+		}
+		fmt.Fprintf(bw, "\x0c\n%s,%d\n%s", section[0].file, sb.Len(), sb.String())
+	}
+
+	return bw.Flush()
+}
+
+// collectTags returns a tag entry for every symbol in the files that aren't skipped
+func collectTags(files []FileIndex, opts TagsOptions) ([]tagEntry, error) {
+	var entries []tagEntry
+
+	for _, file := range files {
+		if isSkipped(file.Path, opts.SkipPatterns) || len(file.Symbols) == 0 {
+			continue
+		}
+
+		absPath := filepath.Join(opts.Root, file.Path)
+		content, err := os.ReadFile(absPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file: %w", err)
+		}
+		lines := strings.Split(string(content), "\n")
+
+		// Byte offset of each line start for etags
+		offsets := make([]int, len(lines))
+		pos := 0
+		for i, line := range lines {
+			offsets[i] = pos
+			pos += len(line) + 1
+		}
+
+		tagFile := absPath
+		if rel, err := filepath.Rel(opts.BaseDir, absPath); err == nil {
+			tagFile = rel
+		}
+		tagFile = filepath.ToSlash(tagFile)
+
+		languages.Walk(file.Symbols, func(sym languages.Symbol, _ int) {
+			// Impl blocks and extensions are named after the type they extend;
+			// tagging them would add a second definition of the type
+			if languages.IsExtension(sym) {
+				return
+			}
+			line := sym.Location().Start.Line
+			if line < 0 || line >= len(lines) {
+				return
+			}
+			entries = append(entries, tagEntry{
+				name:      sym.Name(),
+				file:      tagFile,
+				line:      line,
+				offset:    offsets[line],
+				text:      strings.TrimSuffix(lines[line], "\r"),
+				kind:      sym.Kind(),
+				scope:     tagScope(sym, file.Symbols),
+				signature: tagField(paramList(sym.Name(), sym.String())),
+				language:  file.Language,
+			})
+		})
+	}
+
+	return entries, nil
+}
+
+// tagScope returns the scope field of a symbol ("<kind>:<qualified name>" of
+// its owner), or "" for top-level symbols. Members declared outside of their
+// type (Go methods, Rust impl items) are scoped to the type itself.
+func tagScope(sym languages.Symbol, symbols []languages.Symbol) string {
+	var kind, name string
+	parent := languages.ParentOf(sym)
+	switch scoped, ok := sym.(languages.Scoped); {
+	case ok && scoped.Scope() != "":
+		path := languages.Path(sym)
+		kind, name = "type", strings.Join(path[:len(path)-1], ".")
+	case parent != nil:
+		kind, name = parent.Kind(), languages.QualifiedName(parent)
+	default:
+		return ""
+	}
+	if parent == nil || languages.IsExtension(parent) {
+		for _, owner := range symbols {
+			if owner.Name() == name && !languages.IsExtension(owner) {
+				kind = owner.Kind()
+				break
+			}
+		}
+	}
+	return kind + ":" + tagField(name)
+}
+
+// paramList returns the parameter list following a symbol's name in its
+// signature (e.g., "(ctx context.Context)" for "func (s *Server) Start(ctx
+// context.Context) error"), or "" if the symbol takes no parameters.
+// Generic parameters between the name and the list are skipped.
+func paramList(name, signature string) string {
+	for from := 0; name != ""; {
+		idx := strings.Index(signature[from:], name)
+		if idx < 0 {
+			return ""
+		}
+		rest := signature[from+idx+len(name):]
+		from += idx + len(name)
+		if len(rest) > 0 && (rest[0] == '<' || rest[0] == '[') {
+			rest = rest[balanced(rest):]
+		}
+		if strings.HasPrefix(rest, "(") {
+			return rest[:balanced(rest)]
+		}
+	}
+	return ""
+}
+
+// balanced returns the length of the bracketed group s starts with
+// (e.g., 4 for "(a)b" or 3 for "[T]"), or len(s) if it is not closed
+func balanced(s string) int {
+	open := s[0]
+	closing := map[byte]byte{'(': ')', '[': ']', '<': '>'}[open]
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case open:
+			depth++
+		case closing:
+			if closing == '>' && (s[i-1] == '-' || s[i-1] == '=') {
+				continue // "->" or "=>" inside generic parameters
+			}
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(s)
+}
+
+// escapeTagPattern escapes a line for use in a /^...$/ search pattern
+func escapeTagPattern(line string) string {
+	line = strings.ReplaceAll(line, `\`, `\\`)
+	line = strings.ReplaceAll(line, "/", `\/`)
+	return line
+}
+
+// tagField makes a value safe for a tab-separated tags field
+func tagField(s string) string {
+	return strings.NewReplacer("\t", " ", "\n", " ", "\r", "").Replace(s)
+}
@@ -0,0 +1,136 @@
+package tools
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func writeTagsTestTree(t *testing.T) string {
+	t.Helper()
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"server.py": `class Server:
+    def start(self):
+        pass
+`,
+		"gen/models.py": `def generated():
+    pass
+`,
+		"util/path.py": `def join(a, b):
+    return a + "/" + b
+`,
+		"api/api.go": `package api
+
+type Client struct{}
+
+func (c *Client) Get(path string) error { return nil }
+`,
+		"shapes.rs": `struct Circle;
+
+impl Circle {
+    fn scale<T>(&self, by: T) -> Self { todo!() }
+}
+`,
+	}
+	for name, content := range files {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("failed to create dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	return tmpDir
+}
+
+func TestWriteCtags(t *testing.T) {
+	tmpDir := writeTagsTestTree(t)
+	files, err := IndexDirectory(tmpDir)
+	if err != nil {
+		t.Fatalf("IndexDirectory error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	err = WriteCtags(&buf, files, TagsOptions{Root: tmpDir, BaseDir: tmpDir, SkipPatterns: []string{"gen"}})
+	if err != nil {
+		t.Fatalf("WriteCtags error: %v", err)
+	}
+
+	var tags []string
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if !strings.HasPrefix(line, "!_TAG_") {
+			tags = append(tags, line)
+		}
+	}
+
+	want := []string{
+		"Circle\tshapes.rs\t/^struct Circle;$/;\"\tkind:struct\tline:1\tlanguage:rust",
+		"Client\tapi/api.go\t/^type Client struct{}$/;\"\tkind:type\tline:3\tlanguage:go",
+		"Get\tapi/api.go\t/^func (c *Client) Get(path string) error { return nil }$/;\"\tkind:method\tline:5\tlanguage:go\ttype:Client\tsignature:(string)",
+		"Server\tserver.py\t/^class Server:$/;\"\tkind:class\tline:1\tlanguage:python",
+		"join\tutil/path.py\t/^def join(a, b):$/;\"\tkind:func\tline:1\tlanguage:python\tsignature:(a, b)",
+		"scale\tshapes.rs\t/^    fn scale<T>(&self, by: T) -> Self { todo!() }$/;\"\tkind:method\tline:4\tlanguage:rust\tstruct:Circle\tsignature:(&self, by: T)",
+		"start\tserver.py\t/^    def start(self):$/;\"\tkind:method\tline:2\tlanguage:python\tclass:Server\tsignature:(self)",
+	}
+	if len(tags) != len(want) {
+		t.Fatalf("got %d tags, want %d:\n%s", len(tags), len(want), buf.String())
+	}
+	for i := range want {
+		if tags[i] != want[i] {
+			t.Errorf("tag %d:\ngot  %q\nwant %q", i, tags[i], want[i])
+		}
+	}
+}
+
+func TestWriteEtags(t *testing.T) {
+	tmpDir := writeTagsTestTree(t)
+	files, err := IndexDirectory(tmpDir)
+	if err != nil {
+		t.Fatalf("IndexDirectory error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	err = WriteEtags(&buf, files, TagsOptions{Root: tmpDir, BaseDir: tmpDir})
+	if err != nil {
+		t.Fatalf("WriteEtags error: %v", err)
+	}
+
+	section := "class Server:\x7fServer\x011,0\n    def start(self):\x7fstart\x012,14\n"
+	want := "\x0c\nserver.py," + strconv.Itoa(len(section)) + "\n" + section
+	if !strings.Contains(buf.String(), want) {
+		t.Errorf("missing server.py section %q in:\n%q", want, buf.String())
+	}
+	if !strings.Contains(buf.String(), "\x0c\ngen/models.py,") {
+		t.Errorf("expected unskipped gen/models.py section")
+	}
+}
+
+func TestEscapeTagPattern(t *testing.T) {
+	got := escapeTagPattern(`return a + "/" + b \ c`)
+	want := `return a + "\/" + b \\ c`
+	if got != want {
+		t.Errorf("escapeTagPattern() = %q, want %q", got, want)
+	}
+}
+
+func TestParamList(t *testing.T) {
+	tests := []struct {
+		name, signature, want string
+	}{
+		{"String", "func (s Stringer) String() string", "()"},
+		{"Map", "func Map[T any](xs []T, f func(T) T) []T", "(xs []T, f func(T) T)"},
+		{"apply", "fn apply<F: Fn() -> u8>(f: F) -> u8", "(f: F)"},
+		{"Server", "class Server", ""},
+		{"port", "port = parse(env)", ""},
+	}
+	for _, tt := range tests {
+		if got := paramList(tt.name, tt.signature); got != tt.want {
+			t.Errorf("paramList(%q, %q) = %q, want %q", tt.name, tt.signature, got, tt.want)
+		}
+	}
+}
@@ -20,6 +20,13 @@ type Config struct {
 	LineLimit    int      // Maximum lines in output (0 = no limit)
 }
 
+// generatedFiles are lock files that are skipped even though their format is
+// supported: they are machine-written and would flood the index with keys
+var generatedFiles = map[string]bool{
+	"package-lock.json":   true,
+	"npm-shrinkwrap.json": true,
+}
+
 // FileIndex represents the index of a single source file
 type FileIndex struct {
 	Path      string             `json:"path"`              // Relative path from index root
@@ -29,7 +36,6 @@ type FileIndex struct {
 	Truncated bool               `json:"-"`                 // True if file was truncated due to line limit
 }
 
-// IndexDirectory walks the directory and indexes all supported source files
 // IndexDirectory walks the directory and indexes all supported source files
 func IndexDirectory(dir string) ([]FileIndex, error) {
 	var results []FileIndex
@@ -65,6 +71,9 @@ func IndexDirectory(dir string) ([]FileIndex, error) {
 		if gitignoreMatcher != nil && gitignoreMatcher.Match(relPath, false) {
 			return nil
 		}
+		if generatedFiles[info.Name()] {
+			return nil
+		}
 
 		// Get the language for this file
 		lang := languages.GetLanguageForFile(path)
@@ -81,7 +90,7 @@ func IndexDirectory(dir string) ([]FileIndex, error) {
 		}
 
 		// Parse the file
-		imports, symbols, err := lang.Parse(content)
+		imports, symbols, err := parseContent(lang, path, content)
 		if err != nil {
 			// Skip files that can't be parsed
 			return nil
@@ -100,6 +109,20 @@ func IndexDirectory(dir string) ([]FileIndex, error) {
 	return results, err
 }
 
+// parseContent parses the content of the file at path, turning a panic in the
+// language parser into an error so that one malformed file can't stop indexing
+func parseContent(lang languages.Language, path string, content []byte) (imports []string, symbols []languages.Symbol, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			imports, symbols, err = nil, nil, fmt.Errorf("%s parser panicked: %v", lang.Name(), r)
+		}
+	}()
+	if fp, ok := lang.(languages.FileParser); ok {
+		return fp.ParseFile(path, content)
+	}
+	return lang.Parse(content)
+}
+
 // ParseFile parses a single file and returns its symbols
 func ParseFile(filePath string) ([]languages.Symbol, error) {
 	lang := languages.GetLanguageForFile(filePath)
@@ -112,7 +135,7 @@ func ParseFile(filePath string) ([]languages.Symbol, error) {
 		return nil, fmt.Errorf("failed to read file: %w", err)
 	}
 
-	_, symbols, err := lang.Parse(content)
+	_, symbols, err := parseContent(lang, filePath, content)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse file: %w", err)
 	}
@@ -120,31 +143,79 @@ func ParseFile(filePath string) ([]languages.Symbol, error) {
 	return symbols, nil
 }
 
+// AmbiguousSymbolError is returned when a selector addresses more than one symbol
+type AmbiguousSymbolError struct {
+	Selector   string             // The selector as given
+	File       string             // File that was searched
+	Candidates []languages.Symbol // Every matching symbol in source order
+}
+
+func (e *AmbiguousSymbolError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("symbol %q is ambiguous in %s, candidates:\n", e.Selector, e.File))
+	for i, sym := range e.Candidates {
+		loc := sym.Location()
+		sb.WriteString(fmt.Sprintf("  #%d %s (%s) [%d-%d]\n",
+			i+1, languages.Selectors(sym)[0], sym.Kind(), loc.Start.Line+1, loc.End.Line+1))
+	}
+	sb.WriteString(fmt.Sprintf("use a qualified name or an ordinal (e.g., %q)", e.Selector+"#2"))
+	return sb.String()
+}
+
+// lookupSymbol resolves a selector to exactly one symbol.
+// Selectors are paths (e.g., "Server.handle_request" or "Usage/CLI Mode"),
+// language-specific qualified names (e.g., "(*Server).Start" or
+// "impl Display for Foo::fmt") or plain names, optionally followed by a
+// "#N" ordinal that picks the Nth candidate in source order.
+// Ambiguous selectors return an *AmbiguousSymbolError.
+func lookupSymbol(symbols []languages.Symbol, selector, filePath string) (languages.Symbol, error) {
+	candidates := languages.Lookup(symbols, selector)
+	ordinal := 0
+	if len(candidates) == 0 {
+		var base string
+		base, ordinal = languages.SplitOrdinal(selector)
+		if ordinal > 0 {
+			candidates = languages.Lookup(symbols, base)
+		}
+	}
+
+	switch {
+	case len(candidates) == 0:
+		return nil, fmt.Errorf("symbol %q not found in %s", selector, filePath)
+	case ordinal > len(candidates):
+		return nil, fmt.Errorf("symbol %q not found in %s: only %d candidates", selector, filePath, len(candidates))
+	case ordinal > 0:
+		return candidates[ordinal-1], nil
+	case len(candidates) > 1:
+		return nil, &AmbiguousSymbolError{Selector: selector, File: filePath, Candidates: candidates}
+	}
+	return candidates[0], nil
+}
+
 // FindSymbol finds a symbol by name in a file
 // Returns the symbol and the file content lines for that symbol
 func FindSymbol(filePath string, symbolName string) (languages.Symbol, []string, error) {
+	found, _, lines, err := findSymbol(filePath, symbolName)
+	return found, lines, err
+}
+
+// findSymbol is FindSymbol that also returns all symbols of the file
+func findSymbol(filePath string, symbolName string) (languages.Symbol, []languages.Symbol, []string, error) {
 	symbols, err := ParseFile(filePath)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	// Find the symbol
-	var found languages.Symbol
-	for _, sym := range symbols {
-		if sym.Name() == symbolName {
-			found = sym
-			break
-		}
-	}
-
-	if found == nil {
-		return nil, nil, fmt.Errorf("symbol %q not found in %s", symbolName, filePath)
+	found, err := lookupSymbol(symbols, symbolName, filePath)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
 	// Read the file content
 	content, err := os.ReadFile(filePath)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to read file: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to read file: %w", err)
 	}
 
 	// Extract the lines for the symbol
@@ -161,5 +232,5 @@ func FindSymbol(filePath string, symbolName string) (languages.Symbol, []string,
 		endLine = len(lines) - 1
 	}
 
-	return found, lines[startLine : endLine+1], nil
+	return found, symbols, lines[startLine : endLine+1], nil
 }
@@ -8,12 +8,13 @@ import (
 	"strings"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/roveo/topo-mcp/languages"
 )
 
 // WriteDefinitionInput is the input schema for the write_definition tool
 type WriteDefinitionInput struct {
-	File   string `json:"file" jsonschema_description:"Relative file path from the project root (e.g., 'cmd/main.go', 'src/utils.py')."`
-	Symbol string `json:"symbol" jsonschema_description:"Name of the symbol to replace (function, type, class, method, etc.). For methods, use just the method name without the receiver."`
+	File   string `json:"file,omitempty" jsonschema_description:"Relative file path from the project root (e.g., 'cmd/main.go', 'src/utils.py'). May be omitted when symbol is a topo:// ID."`
+	Symbol string `json:"symbol" jsonschema_description:"Name of the symbol to replace (function, type, class, method, etc.). Nested symbols are addressed by path (e.g., 'Server.handle_request', or 'Usage/CLI Mode' for Markdown sections). Methods can be qualified by receiver (e.g., 'Server.Start', '(*Server).Start', 'impl Display for Foo::fmt'). Append '#N' to pick the Nth of several same-named symbols (e.g., 'String#2'). Ambiguous names return an error listing every candidate."`
 	Code   string `json:"code" jsonschema_description:"The new source code for the symbol. Should be complete and valid code that replaces the entire symbol definition."`
 }
 
@@ -30,74 +31,86 @@ Typical workflow: read_definition → modify → write_definition.`,
 }
 
 // WriteDefinitionHandler handles the write_definition tool invocation
-func WriteDefinitionHandler(cfg *Config) func(context.Context, *mcp.CallToolRequest, WriteDefinitionInput) (*mcp.CallToolResult, any, error) {
-	return func(ctx context.Context, req *mcp.CallToolRequest, input WriteDefinitionInput) (*mcp.CallToolResult, any, error) {
-		if input.File == "" {
-			return nil, nil, fmt.Errorf("file path is required")
-		}
+func WriteDefinitionHandler(cfg *Config) func(context.Context, *mcp.CallToolRequest, WriteDefinitionInput) (*mcp.CallToolResult, WriteDefinitionOutput, error) {
+	return func(ctx context.Context, req *mcp.CallToolRequest, input WriteDefinitionInput) (*mcp.CallToolResult, WriteDefinitionOutput, error) {
 		if input.Symbol == "" {
-			return nil, nil, fmt.Errorf("symbol name is required")
+			return nil, WriteDefinitionOutput{}, fmt.Errorf("symbol name is required")
+		}
+		file, selector, err := resolveSymbolArg(input.File, input.Symbol)
+		if err != nil {
+			return nil, WriteDefinitionOutput{}, err
 		}
 		if input.Code == "" {
-			return nil, nil, fmt.Errorf("code is required")
+			return nil, WriteDefinitionOutput{}, fmt.Errorf("code is required")
 		}
 
 		// Make path absolute if relative
-		filePath := input.File
+		filePath := file
 		if !filepath.IsAbs(filePath) {
 			cwd, err := os.Getwd()
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to get working directory: %w", err)
+				return nil, WriteDefinitionOutput{}, fmt.Errorf("failed to get working directory: %w", err)
 			}
 			filePath = filepath.Join(cwd, filePath)
 		}
 
 		// Check if file exists
 		if _, err := os.Stat(filePath); os.IsNotExist(err) {
-			return nil, nil, fmt.Errorf("file not found: %s", input.File)
+			return nil, WriteDefinitionOutput{}, fmt.Errorf("file not found: %s", file)
 		}
 
 		// Replace the symbol
-		err := ReplaceSymbol(filePath, input.Symbol, input.Code)
+		symbol, symbols, lines, err := replaceSymbol(filePath, selector, input.Code)
+		if err != nil {
+			return nil, WriteDefinitionOutput{}, err
+		}
+		idFile, err := rootRelative(filePath)
 		if err != nil {
-			return nil, nil, err
+			return nil, WriteDefinitionOutput{}, err
 		}
 
 		return &mcp.CallToolResult{
 			Content: []mcp.Content{
-				&mcp.TextContent{Text: fmt.Sprintf("Successfully replaced %s in %s", input.Symbol, input.File)},
+				&mcp.TextContent{Text: fmt.Sprintf("Successfully replaced %s in %s", selector, file)},
 			},
-		}, nil, nil
+		}, WriteDefinitionOutput{
+			File:   filepath.ToSlash(file),
+			Symbol: symbolResult(symbol, symbolIDOf(idFile, symbols, symbol)),
+			Lines:  lines,
+		}, nil
 	}
 }
 
 // ReplaceSymbol replaces a symbol's source code in a file
 func ReplaceSymbol(filePath string, symbolName string, newCode string) error {
+	_, _, _, err := replaceSymbol(filePath, symbolName, newCode)
+	return err
+}
+
+// replaceSymbol is ReplaceSymbol that also returns the replaced symbol, all
+// symbols of the file before the replacement and the range of the new code
+func replaceSymbol(filePath string, symbolName string, newCode string) (languages.Symbol, []languages.Symbol, RangeResult, error) {
 	symbols, err := ParseFile(filePath)
 	if err != nil {
-		return err
+		return nil, nil, RangeResult{}, err
 	}
 
 	// Find the symbol
-	found := -1
-	for i, sym := range symbols {
-		if sym.Name() == symbolName {
-			found = i
-			break
-		}
-	}
-
-	if found == -1 {
-		return fmt.Errorf("symbol %q not found in %s", symbolName, filePath)
+	symbol, err := lookupSymbol(symbols, symbolName, filePath)
+	if err != nil {
+		return nil, nil, RangeResult{}, err
 	}
 
-	symbol := symbols[found]
 	loc := symbol.Location()
+	splice, err := sharesLines(symbols, symbol)
+	if err != nil {
+		return nil, nil, RangeResult{}, err
+	}
 
 	// Read the file content
 	content, err := os.ReadFile(filePath)
 	if err != nil {
-		return fmt.Errorf("failed to read file: %w", err)
+		return nil, nil, RangeResult{}, fmt.Errorf("failed to read file: %w", err)
 	}
 
 	lines := strings.Split(string(content), "\n")
@@ -112,13 +125,26 @@ func ReplaceSymbol(filePath string, symbolName string, newCode string) error {
 		endLine = len(lines) - 1
 	}
 
+	// Add new code (split into lines, trim trailing newline to avoid double)
+	newCode = strings.TrimSuffix(newCode, "\n")
+	codeLines := strings.Split(newCode, "\n")
+
+	// A symbol that shares its lines with other symbols (e.g., "A, B int" or
+	// {"a": 1, "b": 2}) is spliced in by column, keeping its neighbours
+	startColumn, endColumn := 0, len(codeLines[len(codeLines)-1])
+	if splice {
+		startColumn = min(loc.Start.Character, len(lines[startLine]))
+		if len(codeLines) == 1 {
+			endColumn += startColumn
+		}
+		codeLines[0] = lines[startLine][:startColumn] + codeLines[0]
+		codeLines[len(codeLines)-1] += lines[endLine][min(loc.End.Character, len(lines[endLine])):]
+	}
+
 	// Build new content: lines before + new code + lines after
 	var newLines []string
 	newLines = append(newLines, lines[:startLine]...)
-
-	// Add new code (split into lines, trim trailing newline to avoid double)
-	newCode = strings.TrimSuffix(newCode, "\n")
-	newLines = append(newLines, strings.Split(newCode, "\n")...)
+	newLines = append(newLines, codeLines...)
 
 	// Add lines after the symbol
 	if endLine+1 < len(lines) {
@@ -129,8 +155,74 @@ func ReplaceSymbol(filePath string, symbolName string, newCode string) error {
 	newContent := strings.Join(newLines, "\n")
 	err = os.WriteFile(filePath, []byte(newContent), 0o644)
 	if err != nil {
-		return fmt.Errorf("failed to write file: %w", err)
+		return nil, nil, RangeResult{}, fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return symbol, symbols, RangeResult{
+		Start: PositionResult{Line: startLine + 1, Column: startColumn + 1},
+		End:   PositionResult{Line: startLine + len(codeLines), Column: endColumn + 1},
+	}, nil
+}
+
+// sharesLines reports whether another symbol starts or ends on one of the
+// lines of sym, or an enclosing symbol starts before it on its first line or
+// ends after it on its last line (e.g., "type A struct{ X int }"). It returns an error if another symbol
+// overlaps sym itself (e.g., the fields of "A, B int" share one declaration),
+// since replacing one would replace the other too.
+func sharesLines(symbols []languages.Symbol, sym languages.Symbol) (bool, error) {
+	loc := sym.Location()
+	shared := false
+	for _, other := range languages.Flatten(symbols) {
+		if other == sym || isAncestor(sym, other) {
+			continue
+		}
+		o := other.Location()
+		if isAncestor(other, sym) {
+			if (o.Start.Line == loc.Start.Line && before(o.Start, loc.Start)) ||
+				(o.End.Line == loc.End.Line && before(loc.End, o.End)) {
+				shared = true
+			}
+			continue
+		}
+		if o.End.Line < loc.Start.Line || o.Start.Line > loc.End.Line {
+			continue
+		}
+		if before(loc.Start, o.End) && before(o.Start, loc.End) && !sameDeclaration(sym, other) {
+			return false, fmt.Errorf("symbol %q shares its definition with %q: replace the enclosing declaration instead",
+				languages.QualifiedName(sym), languages.QualifiedName(other))
+		}
+		shared = true
 	}
+	return shared, nil
+}
+
+// isAncestor reports whether a is a (transitive) parent of sym
+func isAncestor(a, sym languages.Symbol) bool {
+	for p := languages.ParentOf(sym); p != nil; p = languages.ParentOf(p) {
+		if p == a {
+			return true
+		}
+	}
+	return false
+}
+
+// sameDeclaration reports whether two overlapping symbols can be replaced
+// independently: either one is strictly nested in the other (e.g., the props
+// of a component and the constant they are assigned to), or both are views of
+// the same declaration (e.g., a Svelte prop and its exported variable)
+func sameDeclaration(a, b languages.Symbol) bool {
+	if a.Location() == b.Location() {
+		return a.Name() == b.Name()
+	}
+	return nests(a.Location(), b.Location()) || nests(b.Location(), a.Location())
+}
+
+// nests reports whether range inner lies within range outer
+func nests(outer, inner languages.Range) bool {
+	return !before(inner.Start, outer.Start) && !before(outer.End, inner.End)
+}
 
-	return nil
+// before reports whether position a comes before position b
+func before(a, b languages.Position) bool {
+	return a.Line < b.Line || (a.Line == b.Line && a.Character < b.Character)
 }
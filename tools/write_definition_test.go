@@ -6,8 +6,14 @@ import (
 	"strings"
 	"testing"
 
-	// Import Go language parser for tests
+	// Import language parsers for tests
 	_ "github.com/roveo/topo-mcp/languages/golang"
+	_ "github.com/roveo/topo-mcp/languages/python"
+	_ "github.com/roveo/topo-mcp/languages/rust"
+	_ "github.com/roveo/topo-mcp/languages/sfc"
+	_ "github.com/roveo/topo-mcp/languages/sql"
+	_ "github.com/roveo/topo-mcp/languages/typescript"
+	_ "github.com/roveo/topo-mcp/languages/yaml"
 )
 
 func TestReplaceSymbol(t *testing.T) {
@@ -196,3 +202,297 @@ func Second() {
 		}
 	}
 }
+
+func TestReplaceSymbol_NestedMember(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "server.py")
+	content := `class Server:
+    def start(self):
+        return 1
+
+    def stop(self):
+        return 2
+`
+	err := os.WriteFile(testFile, []byte(content), 0o644)
+	if err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	newCode := `    def start(self):
+        return 42`
+	err = ReplaceSymbol(testFile, "Server.start", newCode)
+	if err != nil {
+		t.Fatalf("ReplaceSymbol error: %v", err)
+	}
+
+	result, err := os.ReadFile(testFile)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+
+	expected := `class Server:
+    def start(self):
+        return 42
+
+    def stop(self):
+        return 2
+`
+	if string(result) != expected {
+		t.Errorf("unexpected result:\n%s", result)
+	}
+}
+
+func TestReplaceSymbol_TraitImplMethod(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "lib.rs")
+	content := `impl fmt::Display for Foo {
+    fn fmt(&self, f: &mut fmt::Formatter) -> fmt::Result {
+        write!(f, "display")
+    }
+}
+
+impl fmt::Debug for Foo {
+    fn fmt(&self, f: &mut fmt::Formatter) -> fmt::Result {
+        write!(f, "debug")
+    }
+}
+`
+	err := os.WriteFile(testFile, []byte(content), 0o644)
+	if err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	// Both impls have a fmt method, so the bare path must not pick one
+	if err := ReplaceSymbol(testFile, "Foo.fmt", "fn fmt() {}"); err == nil {
+		t.Fatal("expected error for ambiguous symbol")
+	}
+
+	newCode := `    fn fmt(&self, f: &mut fmt::Formatter) -> fmt::Result {
+        write!(f, "Foo {{ .. }}")
+    }`
+	err = ReplaceSymbol(testFile, "impl fmt::Debug for Foo::fmt", newCode)
+	if err != nil {
+		t.Fatalf("ReplaceSymbol error: %v", err)
+	}
+
+	result, err := os.ReadFile(testFile)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+	if !strings.Contains(string(result), `write!(f, "display")`) {
+		t.Errorf("Display impl was modified:\n%s", result)
+	}
+	if !strings.Contains(string(result), `write!(f, "Foo {{ .. }}")`) {
+		t.Errorf("Debug impl was not replaced:\n%s", result)
+	}
+}
+
+func TestReplaceSymbol_YAMLKey(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "compose.yaml")
+	content := `services:
+  api:
+    image: app
+    environment:
+      - DEBUG=1
+  # Database
+  db:
+    image: postgres
+`
+	err := os.WriteFile(testFile, []byte(content), 0o644)
+	if err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	newCode := `    environment:
+      - DEBUG=0
+      - LOG_LEVEL=info`
+	err = ReplaceSymbol(testFile, "services.api.environment", newCode)
+	if err != nil {
+		t.Fatalf("ReplaceSymbol error: %v", err)
+	}
+
+	result, err := os.ReadFile(testFile)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+
+	expected := `services:
+  api:
+    image: app
+    environment:
+      - DEBUG=0
+      - LOG_LEVEL=info
+  # Database
+  db:
+    image: postgres
+`
+	if string(result) != expected {
+		t.Errorf("unexpected result:\n%s", result)
+	}
+}
+
+func TestReplaceSymbol_SharedLine(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "config.json")
+	content := `{"name": "app", "version": "1.0.0"}
+`
+	err := os.WriteFile(testFile, []byte(content), 0o644)
+	if err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	err = ReplaceSymbol(testFile, "version", `"version": "2.0.0"`)
+	if err != nil {
+		t.Fatalf("ReplaceSymbol error: %v", err)
+	}
+
+	result, err := os.ReadFile(testFile)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+
+	expected := `{"name": "app", "version": "2.0.0"}
+`
+	if string(result) != expected {
+		t.Errorf("unexpected result:\n%s", result)
+	}
+}
+
+func TestReplaceSymbol_SingleLineContainer(t *testing.T) {
+	tests := []struct {
+		file     string
+		content  string
+		symbol   string
+		code     string
+		expected string
+	}{
+		{"test.go", "package main\n\ntype A struct{ X int }\n", "A.X", "X int64",
+			"package main\n\ntype A struct{ X int64 }\n"},
+		{"lib.rs", "enum E { Only(u32) }\n", "E.Only", "Only(u64)",
+			"enum E { Only(u64) }\n"},
+		{"api.ts", "export const api = { get() { return 1; } };\n", "api.get", "get() { return 2; }",
+			"export const api = { get() { return 2; } };\n"},
+		{"schema.sql", "ALTER TABLE users ADD COLUMN email_verified BOOLEAN;\n", "users.email_verified", "email_verified BOOLEAN NOT NULL",
+			"ALTER TABLE users ADD COLUMN email_verified BOOLEAN NOT NULL;\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.symbol, func(t *testing.T) {
+			testFile := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(testFile, []byte(tt.content), 0o644); err != nil {
+				t.Fatalf("failed to write test file: %v", err)
+			}
+
+			if err := ReplaceSymbol(testFile, tt.symbol, tt.code); err != nil {
+				t.Fatalf("ReplaceSymbol error: %v", err)
+			}
+
+			result, err := os.ReadFile(testFile)
+			if err != nil {
+				t.Fatalf("failed to read file: %v", err)
+			}
+			if string(result) != tt.expected {
+				t.Errorf("unexpected result:\n%s", result)
+			}
+		})
+	}
+}
+
+func TestReplaceSymbol_ComponentProps(t *testing.T) {
+	content := `<script setup lang="ts">
+const props = defineProps<{ label: string }>()
+const emit = defineEmits<{ (e: 'change'): void }>()
+</script>
+`
+	tests := []struct {
+		symbol   string
+		code     string
+		expected string
+	}{
+		{"Button.props", "const props = defineProps<{ label: string; size?: number }>()",
+			"const props = defineProps<{ label: string; size?: number }>()"},
+		{"Button.script-setup.props", "props = defineProps<{ title: string }>()",
+			"const props = defineProps<{ title: string }>()"},
+		{"Button.props.label", "label?: string",
+			"const props = defineProps<{ label?: string }>()"},
+		{"Button.emits", "const emit = defineEmits<{ (e: 'close'): void }>()",
+			"const emit = defineEmits<{ (e: 'close'): void }>()"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.symbol, func(t *testing.T) {
+			testFile := filepath.Join(t.TempDir(), "Button.vue")
+			if err := os.WriteFile(testFile, []byte(content), 0o644); err != nil {
+				t.Fatalf("failed to write test file: %v", err)
+			}
+
+			if err := ReplaceSymbol(testFile, tt.symbol, tt.code); err != nil {
+				t.Fatalf("ReplaceSymbol error: %v", err)
+			}
+
+			result, err := os.ReadFile(testFile)
+			if err != nil {
+				t.Fatalf("failed to read file: %v", err)
+			}
+			if !strings.Contains(string(result), tt.expected+"\n") {
+				t.Errorf("expected %q in result:\n%s", tt.expected, result)
+			}
+			if !strings.HasPrefix(string(result), "<script setup lang=\"ts\">\n") || !strings.HasSuffix(string(result), "</script>\n") {
+				t.Errorf("script section was modified:\n%s", result)
+			}
+		})
+	}
+}
+
+func TestReplaceSymbol_SvelteProp(t *testing.T) {
+	testFile := filepath.Join(t.TempDir(), "Button.svelte")
+	content := "<script>\n  export let label = 'Save'\n</script>\n"
+	if err := os.WriteFile(testFile, []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	if err := ReplaceSymbol(testFile, "Button.props.label", "label = 'Submit'"); err != nil {
+		t.Fatalf("ReplaceSymbol error: %v", err)
+	}
+
+	result, err := os.ReadFile(testFile)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+	expected := "<script>\n  export let label = 'Submit'\n</script>\n"
+	if string(result) != expected {
+		t.Errorf("unexpected result:\n%s", result)
+	}
+}
+
+func TestReplaceSymbol_SharedDeclaration(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	content := `package main
+
+type Point struct {
+	X, Y int
+}
+`
+	err := os.WriteFile(testFile, []byte(content), 0o644)
+	if err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	err = ReplaceSymbol(testFile, "Point.X", "X int64")
+	if err == nil {
+		t.Fatal("expected an error for a field sharing its declaration")
+	}
+	if !strings.Contains(err.Error(), "Point.Y") {
+		t.Errorf("error should name the overlapping symbol, got: %v", err)
+	}
+
+	result, err := os.ReadFile(testFile)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+	if string(result) != content {
+		t.Errorf("file was modified:\n%s", result)
+	}
+}